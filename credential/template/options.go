@@ -86,6 +86,45 @@ func WithTopic[T interface {
 	}
 }
 
+type HasPublisher interface {
+	setPublisher(string)
+}
+
+func WithPublisher[T interface {
+	HasPublisher
+	credential.Descriptor
+}](publisher string) Option[T] {
+	return func(descriptor T) {
+		descriptor.setPublisher(publisher)
+	}
+}
+
+type HasCategory interface {
+	setCategory(string)
+}
+
+func WithCategory[T interface {
+	HasCategory
+	credential.Descriptor
+}](category string) Option[T] {
+	return func(descriptor T) {
+		descriptor.setCategory(category)
+	}
+}
+
+type HasWebPage interface {
+	setWebPage(string)
+}
+
+func WithWebPage[T interface {
+	HasWebPage
+	credential.Descriptor
+}](webPage string) Option[T] {
+	return func(descriptor T) {
+		descriptor.setWebPage(webPage)
+	}
+}
+
 type HasIssuanceDate interface {
 	setIssuanceDate(time.Time)
 }
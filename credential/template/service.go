@@ -0,0 +1,110 @@
+package template
+
+import (
+	"bytes"
+	_ "embed"
+	gotemplate "text/template"
+	"time"
+
+	"github.com/axone-protocol/axone-sdk/credential"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/google/uuid"
+)
+
+//go:embed vc-service-desc-tpl.jsonld
+var serviceTemplate string
+
+var _ credential.Descriptor = &ServiceDescriptor{}
+
+// ServiceDescriptor is a descriptor for generate a digital service description VC.
+// See https://docs.axone.xyz/ontology/next/schemas/credential-digital-service-description
+type ServiceDescriptor struct {
+	id           string
+	serviceDID   string
+	title        string
+	description  string
+	publisher    string
+	tags         []string
+	category     string
+	webPage      string
+	issuanceDate *time.Time
+}
+
+// NewService creates a new digital service description verifiable credential descriptor.
+// ServiceDID and Title are required. If ID is not provided, it will be generated.
+// If issuance date is not provided, it will be set to the current time at descriptor instantiation.
+func NewService(serviceDID, title string, opts ...Option[*ServiceDescriptor]) *ServiceDescriptor {
+	t := time.Now().UTC()
+	s := &ServiceDescriptor{
+		id:           uuid.New().String(),
+		serviceDID:   serviceDID,
+		title:        title,
+		issuanceDate: &t,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *ServiceDescriptor) setID(id string) {
+	s.id = id
+}
+
+func (s *ServiceDescriptor) setDescription(description string) {
+	s.description = description
+}
+
+func (s *ServiceDescriptor) setPublisher(publisher string) {
+	s.publisher = publisher
+}
+
+func (s *ServiceDescriptor) setTags(tags []string) {
+	s.tags = tags
+}
+
+func (s *ServiceDescriptor) setCategory(category string) {
+	s.category = category
+}
+
+func (s *ServiceDescriptor) setWebPage(webPage string) {
+	s.webPage = webPage
+}
+
+func (s *ServiceDescriptor) setIssuanceDate(t time.Time) {
+	s.issuanceDate = &t
+}
+
+func (s *ServiceDescriptor) IssuedAt() *time.Time {
+	return s.issuanceDate
+}
+
+func (s *ServiceDescriptor) ProofPurpose() string {
+	return credential.ProofPurposeAssertionMethod
+}
+
+func (s *ServiceDescriptor) Generate() (*bytes.Buffer, error) {
+	tpl, err := gotemplate.New("serviceDescriptionVC").Parse(serviceTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.Buffer{}
+	err = tpl.Execute(&buf, map[string]any{
+		"NamespacePrefix": dataverse.W3IDPrefix,
+		"CredID":          s.id,
+		"ServiceDID":      s.serviceDID,
+		"Title":           s.title,
+		"Description":     s.description,
+		"Publisher":       s.publisher,
+		"Tags":            s.tags,
+		"Category":        s.category,
+		"WebPage":         s.webPage,
+		"IssuedAt":        s.issuanceDate.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &buf, nil
+}
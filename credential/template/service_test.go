@@ -0,0 +1,95 @@
+package template
+
+import (
+	"testing"
+	"time"
+
+	"github.com/axone-protocol/axone-sdk/credential"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestServiceDescriptor_Generate(t *testing.T) {
+	tests := []struct {
+		name    string
+		vc      credential.Descriptor
+		wantErr error
+		check   func(*verifiable.Credential)
+	}{
+		{
+			name: "Valid service VC",
+			vc: NewService(
+				"serviceID",
+				"title",
+				WithID[*ServiceDescriptor]("id"),
+				WithDescription[*ServiceDescriptor]("description"),
+				WithPublisher[*ServiceDescriptor]("publisher"),
+				WithTags[*ServiceDescriptor]([]string{"tag1", "tag2"}),
+				WithCategory[*ServiceDescriptor]("https://w3id.org/axone/ontology/v4/thesaurus/digital-service-category/Storage"),
+				WithWebPage[*ServiceDescriptor]("https://example.org"),
+				WithIssuanceDate[*ServiceDescriptor](time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			),
+			check: func(vc *verifiable.Credential) {
+				So(vc.ID, ShouldEqual, "https://w3id.org/axone/ontology/v4/schema/credential/digital-service/description/id")
+				So(vc.Types, ShouldResemble, []string{"VerifiableCredential", "DigitalServiceDescriptionCredential"})
+				So(vcSubject(vc).ID, ShouldEqual, "serviceID")
+				So(vc.Issuer.ID, ShouldEqual, "serviceID")
+				So(vcSubject(vc).CustomFields["hasTitle"], ShouldEqual, "title")
+				So(vcSubject(vc).CustomFields["hasDescription"], ShouldEqual, "description")
+				So(vcSubject(vc).CustomFields["hasPublisher"], ShouldEqual, "publisher")
+				So(vcSubject(vc).CustomFields["hasTag"], ShouldResemble, []interface{}{"tag1", "tag2"})
+				So(vcSubject(vc).CustomFields["hasCategory"], ShouldEqual,
+					"https://w3id.org/axone/ontology/v4/thesaurus/digital-service-category/Storage")
+				So(vcSubject(vc).CustomFields["hasWebPage"], ShouldEqual, "https://example.org")
+				So(vc.Issued.Time, ShouldEqual, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+			},
+		},
+		{
+			name: "Valid service VC without options",
+			vc:   NewService("serviceID", "title"),
+			check: func(vc *verifiable.Credential) {
+				So(vc.ID, ShouldStartWith, "https://w3id.org/axone/ontology/v4/schema/credential/digital-service/description/")
+				So(vcSubject(vc).ID, ShouldEqual, "serviceID")
+				So(vc.Issuer.ID, ShouldEqual, "serviceID")
+				So(vcSubject(vc).CustomFields["hasTitle"], ShouldEqual, "title")
+				So(vcSubject(vc).CustomFields["hasDescription"], ShouldEqual, "")
+				So(vcSubject(vc).CustomFields["hasPublisher"], ShouldEqual, "")
+				So(vcSubject(vc).CustomFields["hasTag"], ShouldResemble, []interface{}{})
+				So(vcSubject(vc).CustomFields, ShouldNotContainKey, "hasCategory")
+				So(vcSubject(vc).CustomFields, ShouldNotContainKey, "hasWebPage")
+				So(vc.Issued.Time, ShouldHappenWithin, time.Second, time.Now().UTC())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a credential generator", t, func() {
+				docLoader, err := testutil.MockDocumentLoader()
+				So(err, ShouldBeNil)
+
+				parser := credential.NewDefaultParser(docLoader)
+				generator := credential.New(
+					test.vc,
+					credential.WithParser(parser))
+
+				Convey("When a service VC is generated", func() {
+					vc, err := generator.Generate()
+
+					Convey("Then the service VC should be generated", func() {
+						if test.wantErr != nil {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(vc, ShouldBeNil)
+						} else {
+							So(vc, ShouldNotBeNil)
+							test.check(vc)
+							So(err, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package template
+
+import (
+	"bytes"
+	_ "embed"
+	gotemplate "text/template"
+	"time"
+
+	"github.com/axone-protocol/axone-sdk/credential"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/google/uuid"
+)
+
+//go:embed vc-zone-desc-tpl.jsonld
+var zoneTemplate string
+
+var _ credential.Descriptor = &ZoneDescriptor{}
+
+// ZoneDescriptor is a descriptor for generate a zone description VC.
+// See https://docs.axone.xyz/ontology/next/schemas/credential-zone-description
+type ZoneDescriptor struct {
+	id           string
+	zoneDID      string
+	title        string
+	description  string
+	tags         []string
+	topic        string
+	issuanceDate *time.Time
+}
+
+// NewZone creates a new zone description verifiable credential descriptor.
+// ZoneDID and Title are required. If ID is not provided, it will be generated.
+// If issuance date is not provided, it will be set to the current time at descriptor instantiation.
+func NewZone(zoneDID, title string, opts ...Option[*ZoneDescriptor]) *ZoneDescriptor {
+	t := time.Now().UTC()
+	z := &ZoneDescriptor{
+		id:           uuid.New().String(),
+		zoneDID:      zoneDID,
+		title:        title,
+		issuanceDate: &t,
+	}
+	for _, opt := range opts {
+		opt(z)
+	}
+	return z
+}
+
+func (z *ZoneDescriptor) setID(id string) {
+	z.id = id
+}
+
+func (z *ZoneDescriptor) setDescription(description string) {
+	z.description = description
+}
+
+func (z *ZoneDescriptor) setTags(tags []string) {
+	z.tags = tags
+}
+
+func (z *ZoneDescriptor) setTopic(topic string) {
+	z.topic = topic
+}
+
+func (z *ZoneDescriptor) setIssuanceDate(t time.Time) {
+	z.issuanceDate = &t
+}
+
+func (z *ZoneDescriptor) IssuedAt() *time.Time {
+	return z.issuanceDate
+}
+
+func (z *ZoneDescriptor) ProofPurpose() string {
+	return credential.ProofPurposeAssertionMethod
+}
+
+func (z *ZoneDescriptor) Generate() (*bytes.Buffer, error) {
+	tpl, err := gotemplate.New("zoneDescriptionVC").Parse(zoneTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.Buffer{}
+	err = tpl.Execute(&buf, map[string]any{
+		"NamespacePrefix": dataverse.W3IDPrefix,
+		"CredID":          z.id,
+		"ZoneDID":         z.zoneDID,
+		"Title":           z.title,
+		"Description":     z.description,
+		"Tags":            z.tags,
+		"Topic":           z.topic,
+		"IssuedAt":        z.issuanceDate.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &buf, nil
+}
@@ -0,0 +1,82 @@
+package template
+
+import (
+	"bytes"
+	_ "embed"
+	gotemplate "text/template"
+	"time"
+
+	"github.com/axone-protocol/axone-sdk/credential"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/google/uuid"
+)
+
+//go:embed vc-zone-membership-tpl.jsonld
+var zoneMembershipTemplate string
+
+var _ credential.Descriptor = &ZoneMembershipDescriptor{}
+
+// ZoneMembershipDescriptor is a descriptor for generate a zone membership VC, stating that a resource belongs to a
+// zone. The credential is issued by the zone.
+type ZoneMembershipDescriptor struct {
+	id           string
+	resourceDID  string
+	zoneDID      string
+	issuanceDate *time.Time
+}
+
+// NewZoneMembership creates a new zone membership verifiable credential descriptor.
+// ResourceDID and ZoneDID are required. If ID is not provided, it will be generated.
+// If issuance date is not provided, it will be set to the current time at descriptor instantiation.
+func NewZoneMembership(resourceDID, zoneDID string,
+	opts ...Option[*ZoneMembershipDescriptor],
+) *ZoneMembershipDescriptor {
+	t := time.Now().UTC()
+	m := &ZoneMembershipDescriptor{
+		id:           uuid.New().String(),
+		resourceDID:  resourceDID,
+		zoneDID:      zoneDID,
+		issuanceDate: &t,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+func (m *ZoneMembershipDescriptor) setID(id string) {
+	m.id = id
+}
+
+func (m *ZoneMembershipDescriptor) setIssuanceDate(t time.Time) {
+	m.issuanceDate = &t
+}
+
+func (m *ZoneMembershipDescriptor) IssuedAt() *time.Time {
+	return m.issuanceDate
+}
+
+func (m *ZoneMembershipDescriptor) ProofPurpose() string {
+	return credential.ProofPurposeAssertionMethod
+}
+
+func (m *ZoneMembershipDescriptor) Generate() (*bytes.Buffer, error) {
+	tpl, err := gotemplate.New("zoneMembershipVC").Parse(zoneMembershipTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.Buffer{}
+	err = tpl.Execute(&buf, map[string]any{
+		"NamespacePrefix": dataverse.W3IDPrefix,
+		"CredID":          m.id,
+		"ResourceDID":     m.resourceDID,
+		"ZoneDID":         m.zoneDID,
+		"IssuedAt":        m.issuanceDate.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &buf, nil
+}
@@ -0,0 +1,79 @@
+package template
+
+import (
+	"testing"
+	"time"
+
+	"github.com/axone-protocol/axone-sdk/credential"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestZoneMembershipDescriptor_Generate(t *testing.T) {
+	tests := []struct {
+		name    string
+		vc      credential.Descriptor
+		wantErr error
+		check   func(*verifiable.Credential)
+	}{
+		{
+			name: "Valid zone membership VC",
+			vc: NewZoneMembership(
+				"datasetID",
+				"zoneID",
+				WithID[*ZoneMembershipDescriptor]("id"),
+				WithIssuanceDate[*ZoneMembershipDescriptor](time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			),
+			check: func(vc *verifiable.Credential) {
+				So(vc.ID, ShouldEqual, "https://w3id.org/axone/ontology/v4/schema/credential/zone/membership/id")
+				So(vc.Types, ShouldResemble, []string{"VerifiableCredential", "ZoneMembershipCredential"})
+				So(vcSubject(vc).ID, ShouldEqual, "datasetID")
+				So(vc.Issuer.ID, ShouldEqual, "zoneID")
+				So(vcSubject(vc).CustomFields["isMemberOf"], ShouldEqual, "zoneID")
+				So(vc.Issued.Time, ShouldEqual, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+			},
+		},
+		{
+			name: "Valid zone membership VC with default value",
+			vc:   NewZoneMembership("datasetID", "zoneID"),
+			check: func(vc *verifiable.Credential) {
+				So(vc.ID, ShouldStartWith, "https://w3id.org/axone/ontology/v4/schema/credential/zone/membership/")
+				So(vcSubject(vc).ID, ShouldEqual, "datasetID")
+				So(vc.Issuer.ID, ShouldEqual, "zoneID")
+				So(vcSubject(vc).CustomFields["isMemberOf"], ShouldEqual, "zoneID")
+				So(vc.Issued.Time, ShouldHappenWithin, time.Second, time.Now().UTC())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a credential generator", t, func() {
+				docLoader, err := testutil.MockDocumentLoader()
+				So(err, ShouldBeNil)
+
+				parser := credential.NewDefaultParser(docLoader)
+				generator := credential.New(
+					test.vc,
+					credential.WithParser(parser))
+
+				Convey("When a zone membership VC is generated", func() {
+					vc, err := generator.Generate()
+
+					Convey("Then the zone membership VC should be generated", func() {
+						if test.wantErr != nil {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(vc, ShouldBeNil)
+						} else {
+							So(vc, ShouldNotBeNil)
+							test.check(vc)
+							So(err, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
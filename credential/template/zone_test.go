@@ -0,0 +1,89 @@
+package template
+
+import (
+	"testing"
+	"time"
+
+	"github.com/axone-protocol/axone-sdk/credential"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestZoneDescriptor_Generate(t *testing.T) {
+	tests := []struct {
+		name    string
+		vc      credential.Descriptor
+		wantErr error
+		check   func(*verifiable.Credential)
+	}{
+		{
+			name: "Valid zone VC",
+			vc: NewZone(
+				"zoneID",
+				"title",
+				WithID[*ZoneDescriptor]("id"),
+				WithDescription[*ZoneDescriptor]("description"),
+				WithTags[*ZoneDescriptor]([]string{"tag1", "tag2"}),
+				WithTopic[*ZoneDescriptor]("https://w3id.org/axone/ontology/v4/thesaurus/topic/Test"),
+				WithIssuanceDate[*ZoneDescriptor](time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			),
+			check: func(vc *verifiable.Credential) {
+				So(vc.ID, ShouldEqual, "https://w3id.org/axone/ontology/v4/schema/credential/zone/description/id")
+				So(vc.Types, ShouldResemble, []string{"VerifiableCredential", "ZoneDescriptionCredential"})
+				So(vcSubject(vc).ID, ShouldEqual, "zoneID")
+				So(vc.Issuer.ID, ShouldEqual, "zoneID")
+				So(vcSubject(vc).CustomFields["hasTitle"], ShouldEqual, "title")
+				So(vcSubject(vc).CustomFields["hasDescription"], ShouldEqual, "description")
+				So(vcSubject(vc).CustomFields["hasTag"], ShouldResemble, []interface{}{"tag1", "tag2"})
+				So(vcSubject(vc).CustomFields["hasTopic"], ShouldEqual,
+					"https://w3id.org/axone/ontology/v4/thesaurus/topic/Test")
+				So(vc.Issued.Time, ShouldEqual, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+			},
+		},
+		{
+			name: "Valid zone VC without options",
+			vc:   NewZone("zoneID", "title"),
+			check: func(vc *verifiable.Credential) {
+				So(vc.ID, ShouldStartWith, "https://w3id.org/axone/ontology/v4/schema/credential/zone/description/")
+				So(vcSubject(vc).ID, ShouldEqual, "zoneID")
+				So(vc.Issuer.ID, ShouldEqual, "zoneID")
+				So(vcSubject(vc).CustomFields["hasTitle"], ShouldEqual, "title")
+				So(vcSubject(vc).CustomFields["hasDescription"], ShouldEqual, "")
+				So(vcSubject(vc).CustomFields["hasTag"], ShouldResemble, []interface{}{})
+				So(vcSubject(vc).CustomFields, ShouldNotContainKey, "hasTopic")
+				So(vc.Issued.Time, ShouldHappenWithin, time.Second, time.Now().UTC())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a credential generator", t, func() {
+				docLoader, err := testutil.MockDocumentLoader()
+				So(err, ShouldBeNil)
+
+				parser := credential.NewDefaultParser(docLoader)
+				generator := credential.New(
+					test.vc,
+					credential.WithParser(parser))
+
+				Convey("When a zone VC is generated", func() {
+					vc, err := generator.Generate()
+
+					Convey("Then the zone VC should be generated", func() {
+						if test.wantErr != nil {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(vc, ShouldBeNil)
+						} else {
+							So(vc, ShouldNotBeNil)
+							test.check(vc)
+							So(err, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package dataverse
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/axone-protocol/axone-sdk/prolog"
+)
+
+const (
+	tellIndicator                 = "tell/4"
+	tellPermittedActionsIndicator = "tell_permitted_actions/2"
+)
+
+// GovReport describes what a governance program is able to decide, as found by a static analysis of its code.
+type GovReport struct {
+	// Actions are all the actions explicitly mentioned by the rules, sorted.
+	Actions []string
+	// PermittedActions are the actions mentioned by the rules which may permit them, sorted.
+	PermittedActions []string
+	// AnyAction tells if a rule may permit any action, the action not being constrained to explicit values.
+	AnyAction bool
+	// Rules are the tell/4 and tell_permitted_actions/2 clauses of the program, in their order of declaration.
+	Rules []GovRule
+	// Dependencies are the predicates the rules depend on, directly or through other predicates of the program,
+	// sorted.
+	Dependencies []string
+	// Undefined are the dependencies not defined by the program, i.e. the built-in predicates or predicates expected
+	// to be consulted from elsewhere, sorted.
+	Undefined []string
+}
+
+// GovRule is a tell/4 or tell_permitted_actions/2 clause of a governance program.
+type GovRule struct {
+	// Predicate is the indicator of the predicate defined by the rule, e.g. tell/4.
+	Predicate string
+	// Head of the clause.
+	Head prolog.Term
+	// Body of the clause, i.e. the conditions of the rule, nil for a fact.
+	Body prolog.Term
+	// Actions explicitly mentioned by the rule, either in its head or as the values its action is constrained to.
+	// Empty if the rule applies to any action.
+	Actions []string
+	// Result given by a tell/4 rule, e.g. permitted; it may be a variable bound by the body. Nil for a
+	// tell_permitted_actions/2 rule.
+	Result prolog.Term
+	// Dependencies are the predicates directly called by the body of the rule, in their order of appearance.
+	Dependencies []string
+}
+
+// mayPermit tells if the rule may permit the actions it mentions.
+func (r GovRule) mayPermit() bool {
+	if r.Predicate == tellPermittedActionsIndicator {
+		return true
+	}
+	_, unbound := r.Result.(prolog.Variable)
+	return unbound || r.Result == PermittedResult
+}
+
+func (c *queryClient) AnalyzeGov(ctx context.Context, addr string) (*GovReport, error) {
+	code, err := c.GovCode(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return AnalyzeGovProgram(code)
+}
+
+// AnalyzeGovProgram analyzes the given governance program to report the actions its tell/4 and
+// tell_permitted_actions/2 rules are about and the predicates they depend on.
+func AnalyzeGovProgram(program string) (*GovReport, error) {
+	clauses, err := prolog.ParseProgram(program)
+	if err != nil {
+		return nil, NewDVError(ErrParseProgram, err)
+	}
+
+	bodies := make(map[string][]prolog.Term)
+	report := &GovReport{Rules: []GovRule{}}
+	for _, clause := range clauses {
+		head, body, ok := splitClause(clause)
+		if !ok {
+			continue
+		}
+
+		indicator, _ := predicateIndicator(head)
+		bodies[indicator] = append(bodies[indicator], body)
+		if indicator != tellIndicator && indicator != tellPermittedActionsIndicator {
+			continue
+		}
+
+		report.Rules = append(report.Rules, newGovRule(indicator, head, body))
+	}
+
+	actions := make(map[string]struct{})
+	permitted := make(map[string]struct{})
+	for _, rule := range report.Rules {
+		for _, action := range rule.Actions {
+			actions[action] = struct{}{}
+			if rule.mayPermit() {
+				permitted[action] = struct{}{}
+			}
+		}
+		if len(rule.Actions) == 0 && rule.Predicate == tellIndicator && rule.mayPermit() {
+			report.AnyAction = true
+		}
+	}
+	report.Actions = sortedKeys(actions)
+	report.PermittedActions = sortedKeys(permitted)
+
+	report.Dependencies, report.Undefined = dependencies(bodies)
+
+	return report, nil
+}
+
+func newGovRule(indicator string, head, body prolog.Term) GovRule {
+	args := head.(prolog.Compound).Args
+	rule := GovRule{
+		Predicate: indicator,
+		Head:      head,
+		Body:      body,
+		Actions:   []string{},
+	}
+
+	switch indicator {
+	case tellIndicator:
+		rule.Result = args[2]
+		rule.Actions = actionValues(args[1], body)
+	case tellPermittedActionsIndicator:
+		rule.Actions = actionLists(args[1], body)
+	}
+
+	if body != nil {
+		rule.Dependencies = calledPredicates(body, nil)
+	}
+	return rule
+}
+
+// actionValues returns the actions the given action term may be, either an atom or a variable constrained by the body
+// to be one of explicit atoms.
+func actionValues(action, body prolog.Term) []string {
+	switch v := action.(type) {
+	case prolog.Atom:
+		return []string{string(v)}
+	case prolog.Variable:
+		values := []string{}
+		for _, value := range constraints(v, body) {
+			switch value := value.(type) {
+			case prolog.Atom:
+				values = append(values, string(value))
+			case prolog.List:
+				values = append(values, atoms(value)...)
+			}
+		}
+		return values
+	default:
+		return []string{}
+	}
+}
+
+// actionLists returns the actions of the given list of actions, either explicit or a variable constrained by the
+// body to be an explicit list.
+func actionLists(actions, body prolog.Term) []string {
+	switch v := actions.(type) {
+	case prolog.List:
+		return atoms(v)
+	case prolog.Variable:
+		values := []string{}
+		for _, value := range constraints(v, body) {
+			if list, ok := value.(prolog.List); ok {
+				values = append(values, atoms(list)...)
+			}
+		}
+		return values
+	default:
+		return []string{}
+	}
+}
+
+// constraints returns the terms the given variable is unified with by the body, through =/2, or which it is a member
+// of, through member/2 or memberchk/2, looking into conjunctions and disjunctions.
+func constraints(v prolog.Variable, body prolog.Term) []prolog.Term {
+	goal, ok := body.(prolog.Compound)
+	if !ok {
+		return nil
+	}
+
+	switch {
+	case len(goal.Args) == 2 && (goal.Functor == "," || goal.Functor == ";" || goal.Functor == "->"):
+		return append(constraints(v, goal.Args[0]), constraints(v, goal.Args[1])...)
+	case len(goal.Args) == 2 && goal.Functor == "=":
+		if goal.Args[0] == v {
+			return []prolog.Term{goal.Args[1]}
+		}
+		if goal.Args[1] == v {
+			return []prolog.Term{goal.Args[0]}
+		}
+	case len(goal.Args) == 2 && (goal.Functor == "member" || goal.Functor == "memberchk"):
+		if goal.Args[0] == v {
+			return []prolog.Term{goal.Args[1]}
+		}
+	}
+	return nil
+}
+
+// dependencies returns the predicates reachable from the tell/4 and tell_permitted_actions/2 rules given the bodies of
+// the clauses of each predicate defined by the program, along with the ones among them that are not defined.
+func dependencies(bodies map[string][]prolog.Term) ([]string, []string) {
+	reached := make(map[string]struct{})
+	undefined := make(map[string]struct{})
+
+	pending := []string{tellIndicator, tellPermittedActionsIndicator}
+	visited := map[string]struct{}{tellIndicator: {}, tellPermittedActionsIndicator: {}}
+	for len(pending) > 0 {
+		indicator := pending[0]
+		pending = pending[1:]
+
+		for _, body := range bodies[indicator] {
+			if body == nil {
+				continue
+			}
+			for _, dep := range calledPredicates(body, nil) {
+				if _, ok := visited[dep]; ok {
+					continue
+				}
+				visited[dep] = struct{}{}
+				reached[dep] = struct{}{}
+				if _, defined := bodies[dep]; !defined {
+					undefined[dep] = struct{}{}
+					continue
+				}
+				pending = append(pending, dep)
+			}
+		}
+	}
+
+	return sortedKeys(reached), sortedKeys(undefined)
+}
+
+// calledPredicates appends to the given indicators the ones of the predicates called by the given goal, looking
+// through control constructs and meta-calls.
+func calledPredicates(goal prolog.Term, indicators []string) []string {
+	switch g := goal.(type) {
+	case prolog.Atom:
+		if g == "!" || g == "true" {
+			return indicators
+		}
+		return appendUnique(indicators, fmt.Sprintf("%s/0", g))
+	case prolog.Compound:
+		switch {
+		case len(g.Args) == 2 && (g.Functor == "," || g.Functor == ";" || g.Functor == "->" || g.Functor == "*->"):
+			return calledPredicates(g.Args[1], calledPredicates(g.Args[0], indicators))
+		case len(g.Args) == 1 && (g.Functor == `\+` || g.Functor == "call"):
+			return calledPredicates(g.Args[0], indicators)
+		case g.Functor == "call":
+			return calledPredicates(extendGoal(g.Args[0], g.Args[1:]), indicators)
+		}
+
+		indicator, _ := predicateIndicator(g)
+		indicators = appendUnique(indicators, indicator)
+		switch indicator {
+		case "findall/3", "findall/4", "bagof/3", "setof/3", "aggregate_all/3":
+			return calledPredicates(stripExistential(g.Args[1]), indicators)
+		case "forall/2":
+			return calledPredicates(g.Args[1], calledPredicates(g.Args[0], indicators))
+		case "once/1", "ignore/1":
+			return calledPredicates(g.Args[0], indicators)
+		case "catch/3":
+			return calledPredicates(g.Args[2], calledPredicates(g.Args[0], indicators))
+		}
+	}
+	return indicators
+}
+
+// extendGoal adds the given arguments to a goal, as call/N does.
+func extendGoal(goal prolog.Term, args []prolog.Term) prolog.Term {
+	switch g := goal.(type) {
+	case prolog.Atom:
+		return prolog.Compound{Functor: g, Args: args}
+	case prolog.Compound:
+		return prolog.Compound{Functor: g.Functor, Args: append(append([]prolog.Term{}, g.Args...), args...)}
+	default:
+		return goal
+	}
+}
+
+// stripExistential removes the existential quantifiers (Var^Goal) of a bagof/3 or setof/3 goal.
+func stripExistential(goal prolog.Term) prolog.Term {
+	for {
+		g, ok := goal.(prolog.Compound)
+		if !ok || g.Functor != "^" || len(g.Args) != 2 {
+			return goal
+		}
+		goal = g.Args[1]
+	}
+}
+
+// splitClause returns the head and body of a clause, the body being nil for a fact. Directives are not clauses.
+func splitClause(clause prolog.Term) (prolog.Term, prolog.Term, bool) {
+	c, ok := clause.(prolog.Compound)
+	if ok && c.Functor == ":-" {
+		if len(c.Args) != 2 {
+			return nil, nil, false
+		}
+		if _, ok := predicateIndicator(c.Args[0]); !ok {
+			return nil, nil, false
+		}
+		return c.Args[0], c.Args[1], true
+	}
+
+	if _, ok := predicateIndicator(clause); !ok {
+		return nil, nil, false
+	}
+	return clause, nil, true
+}
+
+// predicateIndicator returns the Name/Arity indicator of a callable term.
+func predicateIndicator(t prolog.Term) (string, bool) {
+	switch v := t.(type) {
+	case prolog.Atom:
+		return fmt.Sprintf("%s/0", v), true
+	case prolog.Compound:
+		return fmt.Sprintf("%s/%d", v.Functor, len(v.Args)), true
+	default:
+		return "", false
+	}
+}
+
+func atoms(list prolog.List) []string {
+	values := make([]string, 0, len(list.Elements))
+	for _, e := range list.Elements {
+		if a, ok := e.(prolog.Atom); ok {
+			values = append(values, string(a))
+		}
+	}
+	return values
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
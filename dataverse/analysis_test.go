@@ -0,0 +1,157 @@
+package dataverse_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/prolog"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+const analyzedProgram = `
+:- discontiguous(tell/4).
+
+% The owner can do anything.
+tell(Who, _, permitted, []) :- is_owner(Who), !.
+
+tell(Who, Action, permitted, []) :-
+    member(Action, [read, 'list']),
+    \+ banned(Who), !.
+
+tell(Who, store, Result, Evidence) :-
+    ( has_credit(Who) -> Result = permitted, Evidence = [] ; Result = prohibited, Evidence = [no_credit] ).
+
+tell(_, Action, prohibited, [cause(Action)]) :- Action = delete.
+
+tell_permitted_actions(Who, Actions) :- findall(A, tell(Who, A, permitted, _), Actions).
+tell_permitted_actions(_, [read]).
+
+is_owner(Who) :- owner(Who).
+owner('did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5').
+
+banned(Who) :- setof(W, Reason^ban(W, Reason), Banned), memberchk(Who, Banned).
+
+has_credit(Who) :- bank_balances(Who, Balances), Balances \= [].
+`
+
+func TestAnalyzeGovProgram(t *testing.T) {
+	Convey("Given a governance program", t, func() {
+		Convey("When it is analyzed", func() {
+			report, err := dataverse.AnalyzeGovProgram(analyzedProgram)
+
+			Convey("Then the actions and dependencies of its rules should be reported", func() {
+				So(err, ShouldBeNil)
+				So(report.Actions, ShouldResemble, []string{"delete", "list", "read", "store"})
+				So(report.PermittedActions, ShouldResemble, []string{"list", "read", "store"})
+				So(report.AnyAction, ShouldBeTrue)
+				So(report.Dependencies, ShouldResemble, []string{
+					"=/2", `\=/2`, "ban/2", "bank_balances/2", "banned/1", "findall/3", "has_credit/1", "is_owner/1",
+					"member/2", "memberchk/2", "owner/1", "setof/3",
+				})
+				So(report.Undefined, ShouldResemble, []string{
+					"=/2", `\=/2`, "ban/2", "bank_balances/2", "findall/3", "member/2", "memberchk/2", "setof/3",
+				})
+
+				So(report.Rules, ShouldHaveLength, 6)
+				So(report.Rules[0].Predicate, ShouldEqual, "tell/4")
+				So(report.Rules[0].Actions, ShouldBeEmpty)
+				So(report.Rules[0].Result, ShouldEqual, prolog.Atom("permitted"))
+				So(report.Rules[0].Dependencies, ShouldResemble, []string{"is_owner/1"})
+
+				So(report.Rules[1].Actions, ShouldResemble, []string{"read", "list"})
+				So(report.Rules[1].Dependencies, ShouldResemble, []string{"member/2", "banned/1"})
+
+				So(report.Rules[2].Actions, ShouldResemble, []string{"store"})
+				So(report.Rules[2].Result, ShouldEqual, prolog.Variable("Result"))
+				So(report.Rules[2].Dependencies, ShouldResemble, []string{"has_credit/1", "=/2"})
+
+				So(report.Rules[3].Actions, ShouldResemble, []string{"delete"})
+				So(report.Rules[3].Result, ShouldEqual, prolog.Atom("prohibited"))
+
+				So(report.Rules[4].Predicate, ShouldEqual, "tell_permitted_actions/2")
+				So(report.Rules[4].Actions, ShouldBeEmpty)
+				So(report.Rules[4].Result, ShouldBeNil)
+				So(report.Rules[4].Dependencies, ShouldResemble, []string{"findall/3", "tell/4"})
+
+				So(report.Rules[5].Actions, ShouldResemble, []string{"read"})
+				So(report.Rules[5].Body, ShouldBeNil)
+				So(report.Rules[5].Dependencies, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given an invalid governance program", t, func() {
+		Convey("When it is analyzed", func() {
+			report, err := dataverse.AnalyzeGovProgram("tell(_, read, permitted, [])")
+
+			Convey("Then a parse error should be returned", func() {
+				So(err.Error(), ShouldEqual, "could not parse governance program: syntax error at offset 28: unexpected end of input")
+				So(report, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestClient_AnalyzeGov(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          *string
+		responseError error
+		wantErr       error
+		wantActions   []string
+	}{
+		{
+			name:          "law stone client program code error",
+			responseError: fmt.Errorf("error"),
+			wantErr:       fmt.Errorf("failed to query law-stone contract: error"),
+		},
+		{
+			name:        "governance analyzed",
+			code:        toAddress(base64.StdEncoding.EncodeToString([]byte(analyzedProgram))),
+			wantActions: []string{"delete", "list", "read", "store"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a mocked law-stone client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				lawStoneMock := testutil.NewMockLawStoneQueryClient(controller)
+				lawStoneMock.EXPECT().
+					ProgramCode(gomock.Any(), &lsschema.QueryMsg_ProgramCode{}).
+					Return(test.code, test.responseError).
+					Times(1)
+
+				client := dataverse.NewDataverseQueryClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					testutil.NewMockCognitariumQueryClient(controller),
+					func(_ string) (lsschema.QueryClient, error) {
+						return lawStoneMock, nil
+					},
+				)
+
+				Convey("When AnalyzeGov is called", func() {
+					report, err := client.AnalyzeGov(context.Background(), "foo")
+
+					Convey("Then the report of the governance code should be returned", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(report.Actions, ShouldResemble, test.wantActions)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(report, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
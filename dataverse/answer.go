@@ -0,0 +1,99 @@
+package dataverse
+
+import (
+	"context"
+	"fmt"
+
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axone-sdk/prolog"
+)
+
+// GovAnswer is the answer of a governance (law-stone contract) to a Prolog query, with the substitutions parsed as
+// Prolog terms.
+type GovAnswer struct {
+	// Variables of the query.
+	Variables []string
+	// HasMore tells if the governance has more results than the ones returned.
+	HasMore bool
+	// Results are the solutions found for the query.
+	Results []GovResult
+}
+
+// GovResult is a solution of a query asked to a governance.
+type GovResult struct {
+	// Error raised by the Prolog engine while looking for this solution, if any.
+	Error string
+	// Substitutions are the terms the query variables are bound to, in the order returned by the governance.
+	Substitutions []Substitution
+}
+
+// Substitution is the binding of a query variable to a Prolog term.
+type Substitution struct {
+	Variable string
+	Term     prolog.Term
+}
+
+// Get returns the term bound to the given variable, if any.
+func (r GovResult) Get(variable string) (prolog.Term, bool) {
+	for _, s := range r.Substitutions {
+		if s.Variable == variable {
+			return s.Term, true
+		}
+	}
+	return nil, false
+}
+
+func (c *queryClient) AskGov(ctx context.Context, addr, query string) (*GovAnswer, error) {
+	gov, err := c.lawStoneFactory(addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create law-stone client: %w", err)
+	}
+
+	response, err := gov.Ask(ctx, &lsschema.QueryMsg_Ask{Query: query})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query law-stone contract: %w", err)
+	}
+	if isBroken(response.Answer) {
+		return nil, NewDVError(ErrBrokenGov, fmt.Errorf("law-stone contract %s", addr))
+	}
+
+	return newGovAnswer(response.Answer)
+}
+
+func newGovAnswer(answer *lsschema.Answer) (*GovAnswer, error) {
+	if answer == nil {
+		return &GovAnswer{}, nil
+	}
+
+	results := make([]GovResult, 0, len(answer.Results))
+	for _, result := range answer.Results {
+		substitutions := make([]Substitution, 0, len(result.Substitutions))
+		for _, s := range result.Substitutions {
+			term, err := prolog.Parse(s.Expression)
+			if err != nil {
+				return nil, NewDVError(ErrParseTerm, err)
+			}
+			substitutions = append(substitutions, Substitution{Variable: s.Variable, Term: term})
+		}
+
+		govResult := GovResult{Substitutions: substitutions}
+		if result.Error != nil {
+			govResult.Error = *result.Error
+		}
+		results = append(results, govResult)
+	}
+
+	return &GovAnswer{
+		Variables: answer.Variables,
+		HasMore:   answer.HasMore,
+		Results:   results,
+	}, nil
+}
+
+// singleTerm returns the term of an answer made of a single result binding a single variable.
+func (a *GovAnswer) singleTerm() (prolog.Term, bool) {
+	if len(a.Results) != 1 || len(a.Results[0].Substitutions) != 1 {
+		return nil, false
+	}
+	return a.Results[0].Substitutions[0].Term, true
+}
@@ -0,0 +1,179 @@
+package dataverse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/prolog"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestClient_AskGov(t *testing.T) {
+	tests := []struct {
+		name          string
+		addr          string
+		query         string
+		response      *lsschema.AskResponse
+		responseError error
+		wantErr       error
+		wantResult    *dataverse.GovAnswer
+	}{
+		{
+			name:    "law stone client new error",
+			addr:    "error",
+			query:   "foo(X).",
+			wantErr: fmt.Errorf("failed to create law-stone client: error"),
+		},
+		{
+			name:          "law stone client ask error",
+			addr:          "foo",
+			query:         "foo(X).",
+			responseError: fmt.Errorf("error"),
+			wantErr:       fmt.Errorf("failed to query law-stone contract: error"),
+		},
+		{
+			name:       "no answer in response",
+			addr:       "foo",
+			query:      "foo(X).",
+			response:   &lsschema.AskResponse{},
+			wantResult: &dataverse.GovAnswer{},
+		},
+		{
+			name:  "structured terms in response",
+			addr:  "foo",
+			query: "tell('did:key:abc',read,Result,Evidence).",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					HasMore:   true,
+					Variables: []string{"Result", "Evidence"},
+					Results: []lsschema.Result{
+						{
+							Substitutions: []lsschema.Substitution{
+								{Variable: "Result", Expression: "permitted"},
+								{Variable: "Evidence", Expression: "cause('did:key:abc',[read, 'a,b'])"},
+							},
+						},
+						{
+							Error: toAddress("error(resource_error(gas),root)"),
+						},
+					},
+				},
+			},
+			wantResult: &dataverse.GovAnswer{
+				HasMore:   true,
+				Variables: []string{"Result", "Evidence"},
+				Results: []dataverse.GovResult{
+					{
+						Substitutions: []dataverse.Substitution{
+							{Variable: "Result", Term: prolog.Atom("permitted")},
+							{Variable: "Evidence", Term: prolog.Compound{
+								Functor: "cause",
+								Args: []prolog.Term{
+									prolog.Atom("did:key:abc"),
+									prolog.List{Elements: []prolog.Term{prolog.Atom("read"), prolog.Atom("a,b")}},
+								},
+							}},
+						},
+					},
+					{
+						Error:         "error(resource_error(gas),root)",
+						Substitutions: []dataverse.Substitution{},
+					},
+				},
+			},
+		},
+		{
+			name:  "broken law stone",
+			addr:  "foo",
+			query: "foo(X).",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{
+						{Error: toAddress("error(system_error(broken_law_stone),root)")},
+					},
+				},
+			},
+			wantErr: dataverse.NewDVError(dataverse.ErrBrokenGov, fmt.Errorf("law-stone contract foo")),
+		},
+		{
+			name:  "malformed term in response",
+			addr:  "foo",
+			query: "foo(X).",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{
+						{Substitutions: []lsschema.Substitution{{Variable: "X", Expression: "foo("}}},
+					},
+				},
+			},
+			wantErr: dataverse.NewDVError(dataverse.ErrParseTerm, fmt.Errorf("syntax error at offset 4: unexpected end of input")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a mocked law-stone client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				lawStoneMock := testutil.NewMockLawStoneQueryClient(controller)
+				lawStoneMock.
+					EXPECT().
+					Ask(gomock.Any(), &lsschema.QueryMsg_Ask{Query: test.query}).
+					Return(test.response, test.responseError).
+					AnyTimes()
+
+				client := dataverse.NewDataverseQueryClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					testutil.NewMockCognitariumQueryClient(controller),
+					func(addr string) (lsschema.QueryClient, error) {
+						if addr == "error" {
+							return nil, fmt.Errorf("error")
+						}
+
+						return lawStoneMock, nil
+					},
+				)
+
+				Convey("When AskGov is called", func() {
+					answer, err := client.AskGov(context.Background(), test.addr, test.query)
+
+					Convey("Then the parsed answer should be returned", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(answer, ShouldResemble, test.wantResult)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(answer, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestGovResult_Get(t *testing.T) {
+	Convey("Given a governance result", t, func() {
+		result := dataverse.GovResult{
+			Substitutions: []dataverse.Substitution{
+				{Variable: "Result", Term: prolog.Atom("permitted")},
+			},
+		}
+
+		Convey("Then the term bound to a variable should be returned", func() {
+			term, ok := result.Get("Result")
+			So(ok, ShouldBeTrue)
+			So(term, ShouldEqual, prolog.Atom("permitted"))
+
+			term, ok = result.Get("Evidence")
+			So(ok, ShouldBeFalse)
+			So(term, ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,180 @@
+package dataverse
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/axone-protocol/axone-sdk/prolog"
+)
+
+// Permission is an action an identity asks to perform.
+type Permission struct {
+	// DID of the identity asking to perform the action.
+	DID string
+	// Action asked to be performed.
+	Action string
+}
+
+// ResourcePermission is an action an identity asks to perform on a resource.
+type ResourcePermission struct {
+	// Resource is the DID of the resource the action is about.
+	Resource string
+	Permission
+}
+
+// govDecisionsChunkSize is the maximum number of permissions asked in a single query by AskGovDecisions, so that the
+// query stays within the gas limit of the law-stone contract.
+const govDecisionsChunkSize = 25
+
+func (c *queryClient) AskGovDecisions(ctx context.Context, addr string, permissions []Permission) ([]*Decision, error) {
+	decisions := make([]*Decision, 0, len(permissions))
+	for chunk := range slices.Chunk(permissions, govDecisionsChunkSize) {
+		chunkDecisions, err := c.askGovDecisions(ctx, addr, chunk)
+		if err != nil {
+			return nil, err
+		}
+		decisions = append(decisions, chunkDecisions...)
+	}
+
+	return decisions, nil
+}
+
+// askGovDecisions asks the decisions about the given permissions in a single query.
+func (c *queryClient) askGovDecisions(ctx context.Context, addr string, permissions []Permission) ([]*Decision, error) {
+	query := prolog.NewQuery()
+	for i, p := range permissions {
+		query.Goal("findall",
+			prolog.Compound{Functor: "-", Args: []prolog.Term{prolog.Variable("Result"), prolog.Variable("Evidence")}},
+			prolog.Compound{Functor: "tell", Args: []prolog.Term{
+				prolog.Atom(p.DID), prolog.Atom(p.Action), prolog.Variable("Result"), prolog.Variable("Evidence"),
+			}},
+			decisionsVariable(i),
+		)
+	}
+	q, err := query.Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build governance query: %w", err)
+	}
+
+	answer, err := c.AskGov(ctx, addr, q)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := make([]*Decision, 0, len(permissions))
+	for i, p := range permissions {
+		decision := &Decision{GovAddr: addr, DID: p.DID, Action: p.Action}
+		if len(answer.Results) == 1 {
+			result := answer.Results[0]
+			decision.Error = result.Error
+			if term, ok := result.Get(string(decisionsVariable(i))); ok {
+				if err := decision.setOutcome(term); err != nil {
+					return nil, err
+				}
+			}
+		}
+		decisions = append(decisions, decision)
+	}
+
+	return decisions, nil
+}
+
+func (c *queryClient) AskResourcesDecisions(
+	ctx context.Context,
+	permissions []ResourcePermission,
+) ([]*Decision, error) {
+	return askResourcesDecisions(ctx, c, c, permissions)
+}
+
+// govAddrsResolver resolves the governance addresses of many resources at once, indexed by resource, the resources
+// without governance being left out.
+type govAddrsResolver interface {
+	getResourcesGovAddrs(ctx context.Context, resources []string) (map[string]string, error)
+}
+
+// askResourcesDecisions resolves the governance of the resources through the given resolver and asks each governance
+// its decisions through the given client.
+func askResourcesDecisions(
+	ctx context.Context,
+	c QueryClient,
+	resolver govAddrsResolver,
+	permissions []ResourcePermission,
+) ([]*Decision, error) {
+	var resources []string
+	for _, p := range permissions {
+		if !slices.Contains(resources, p.Resource) {
+			resources = append(resources, p.Resource)
+		}
+	}
+	resourceAddrs, err := resolver.getResourcesGovAddrs(ctx, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	var addrs []string
+	grouped := make(map[string][]Permission)
+	indexes := make(map[string]map[Permission]int)
+	for _, p := range permissions {
+		addr, ok := resourceAddrs[p.Resource]
+		if !ok {
+			continue
+		}
+		if _, ok := grouped[addr]; !ok {
+			addrs = append(addrs, addr)
+			indexes[addr] = make(map[Permission]int)
+		}
+		if _, ok := indexes[addr][p.Permission]; !ok {
+			indexes[addr][p.Permission] = len(grouped[addr])
+			grouped[addr] = append(grouped[addr], p.Permission)
+		}
+	}
+
+	decisions := make(map[string][]*Decision, len(addrs))
+	for _, addr := range addrs {
+		govDecisions, err := c.AskGovDecisions(ctx, addr, grouped[addr])
+		if err != nil {
+			return nil, err
+		}
+		decisions[addr] = govDecisions
+	}
+
+	result := make([]*Decision, 0, len(permissions))
+	for _, p := range permissions {
+		addr, ok := resourceAddrs[p.Resource]
+		if !ok {
+			result = append(result, nil)
+			continue
+		}
+		result = append(result, decisions[addr][indexes[addr][p.Permission]])
+	}
+
+	return result, nil
+}
+
+// setOutcome sets the result and evidence of the decision from the single Result-Evidence pair of the given list; as
+// for AskGovDecision, no decision is given if the tell/4 predicate has no or several solutions.
+func (d *Decision) setOutcome(term prolog.Term) error {
+	list, ok := term.(prolog.List)
+	if !ok {
+		return NewDVError(ErrType, fmt.Errorf("expected list of decisions, got %s", term))
+	}
+	if len(list.Elements) != 1 {
+		return nil
+	}
+
+	pair, ok := list.Elements[0].(prolog.Compound)
+	if !ok || pair.Functor != "-" || len(pair.Args) != 2 {
+		return NewDVError(ErrType, fmt.Errorf("expected Result-Evidence pair, got %s", list.Elements[0]))
+	}
+
+	d.Result = pair.Args[0]
+	if _, unbound := pair.Args[1].(prolog.Variable); !unbound {
+		d.Evidence = pair.Args[1]
+	}
+	return nil
+}
+
+func decisionsVariable(i int) prolog.Variable {
+	return prolog.Variable(fmt.Sprintf("Decisions%d", i))
+}
@@ -0,0 +1,470 @@
+package dataverse_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/prolog"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+func TestClient_AskGovDecisions(t *testing.T) {
+	const (
+		did1 = "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5"
+		did2 = "did:key:zQ3shpoUHzwcgdt2gxjqHHnJnNkBVd4uX3ZBhmPiM7J93yqCr"
+	)
+
+	permissions := []dataverse.Permission{{DID: did1, Action: "read"}, {DID: did2, Action: "write"}}
+	query := fmt.Sprintf("findall('-'(Result,Evidence),'tell'('%s','read',Result,Evidence),Decisions0),"+
+		"findall('-'(Result,Evidence),'tell'('%s','write',Result,Evidence),Decisions1).", did1, did2)
+
+	tests := []struct {
+		name          string
+		permissions   []dataverse.Permission
+		response      *lsschema.AskResponse
+		responseError error
+		wantErr       error
+		wantResult    []*dataverse.Decision
+	}{
+		{
+			name:        "no permission",
+			permissions: []dataverse.Permission{},
+			wantResult:  []*dataverse.Decision{},
+		},
+		{
+			name:          "law stone client ask error",
+			permissions:   permissions,
+			responseError: fmt.Errorf("error"),
+			wantErr:       fmt.Errorf("failed to query law-stone contract: error"),
+		},
+		{
+			name:        "decisions with and without evidence",
+			permissions: permissions,
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Variables: []string{"Result", "Evidence", "Decisions0", "Decisions1"},
+					Results: []lsschema.Result{
+						{
+							Substitutions: []lsschema.Substitution{
+								{Variable: "Decisions0", Expression: "[permitted-_1]"},
+								{Variable: "Decisions1", Expression: "[prohibited-cause('not the owner')]"},
+							},
+						},
+					},
+				},
+			},
+			wantResult: []*dataverse.Decision{
+				{GovAddr: "foo", DID: did1, Action: "read", Result: prolog.Atom("permitted")},
+				{
+					GovAddr:  "foo",
+					DID:      did2,
+					Action:   "write",
+					Result:   prolog.Atom("prohibited"),
+					Evidence: prolog.Compound{Functor: "cause", Args: []prolog.Term{prolog.Atom("not the owner")}},
+				},
+			},
+		},
+		{
+			name:        "no decision for a permission",
+			permissions: permissions,
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{
+						{
+							Substitutions: []lsschema.Substitution{
+								{Variable: "Decisions0", Expression: "[]"},
+								{Variable: "Decisions1", Expression: "[permitted-[]]"},
+							},
+						},
+					},
+				},
+			},
+			wantResult: []*dataverse.Decision{
+				{GovAddr: "foo", DID: did1, Action: "read"},
+				{GovAddr: "foo", DID: did2, Action: "write", Result: prolog.Atom("permitted"), Evidence: prolog.List{}},
+			},
+		},
+		{
+			name:        "several decisions for a permission",
+			permissions: permissions,
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{
+						{
+							Substitutions: []lsschema.Substitution{
+								{Variable: "Decisions0", Expression: "[permitted-[],prohibited-[]]"},
+								{Variable: "Decisions1", Expression: "[permitted-[]]"},
+							},
+						},
+					},
+				},
+			},
+			wantResult: []*dataverse.Decision{
+				{GovAddr: "foo", DID: did1, Action: "read"},
+				{GovAddr: "foo", DID: did2, Action: "write", Result: prolog.Atom("permitted"), Evidence: prolog.List{}},
+			},
+		},
+		{
+			name:        "error while deciding",
+			permissions: permissions,
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{
+						{Error: toAddress("error(resource_error(gas),root)")},
+					},
+				},
+			},
+			wantResult: []*dataverse.Decision{
+				{GovAddr: "foo", DID: did1, Action: "read", Error: "error(resource_error(gas),root)"},
+				{GovAddr: "foo", DID: did2, Action: "write", Error: "error(resource_error(gas),root)"},
+			},
+		},
+		{
+			name:        "unexpected decisions",
+			permissions: permissions,
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{
+						{
+							Substitutions: []lsschema.Substitution{
+								{Variable: "Decisions0", Expression: "permitted"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: dataverse.NewDVError(dataverse.ErrType, fmt.Errorf("expected list of decisions, got permitted")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a mocked law-stone client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				lawStoneMock := testutil.NewMockLawStoneQueryClient(controller)
+				if len(test.permissions) != 0 {
+					lawStoneMock.EXPECT().
+						Ask(gomock.Any(), &lsschema.QueryMsg_Ask{Query: query}).
+						Return(test.response, test.responseError).
+						Times(1)
+				}
+
+				client := dataverse.NewDataverseQueryClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					testutil.NewMockCognitariumQueryClient(controller),
+					func(_ string) (lsschema.QueryClient, error) {
+						return lawStoneMock, nil
+					},
+				)
+
+				Convey("When AskGovDecisions is called", func() {
+					decisions, err := client.AskGovDecisions(context.Background(), "foo", test.permissions)
+
+					Convey("Then the decisions should be returned in the order of the permissions", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(decisions, ShouldResemble, test.wantResult)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(decisions, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestClient_AskGovDecisionsInChunks(t *testing.T) {
+	Convey("Given a mocked law-stone client", t, func() {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		var goals []int
+		lawStoneMock := testutil.NewMockLawStoneQueryClient(controller)
+		lawStoneMock.EXPECT().
+			Ask(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, msg *lsschema.QueryMsg_Ask, _ ...grpc.CallOption) (*lsschema.AskResponse, error) {
+				goals = append(goals, strings.Count(msg.Query, "findall("))
+				return &lsschema.AskResponse{Answer: &lsschema.Answer{Results: []lsschema.Result{{}}}}, nil
+			}).
+			Times(2)
+
+		client := dataverse.NewDataverseQueryClient(
+			testutil.NewMockDataverseQueryClient(controller),
+			testutil.NewMockCognitariumQueryClient(controller),
+			func(_ string) (lsschema.QueryClient, error) {
+				return lawStoneMock, nil
+			},
+		)
+
+		Convey("When AskGovDecisions is called with many permissions", func() {
+			permissions := make([]dataverse.Permission, 30)
+			for i := range permissions {
+				permissions[i] = dataverse.Permission{DID: fmt.Sprintf("did:key:user%d", i), Action: "read"}
+			}
+			decisions, err := client.AskGovDecisions(context.Background(), "foo", permissions)
+
+			Convey("Then the permissions should be asked in chunks", func() {
+				So(err, ShouldBeNil)
+				So(goals, ShouldResemble, []int{25, 5})
+				So(decisions, ShouldHaveLength, 30)
+				So(decisions[29].DID, ShouldEqual, "did:key:user29")
+			})
+		})
+	})
+}
+
+func TestClient_AskResourcesDecisions(t *testing.T) {
+	const did = "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5"
+
+	govBinding := func(resource, gov string) map[string]cgschema.Value {
+		return map[string]cgschema.Value{
+			"resource": uriValue(resource),
+			"code":     uriValue("contract:law-stone:" + gov),
+		}
+	}
+
+	tests := []struct {
+		name          string
+		resourceGovs  []map[string]cgschema.Value
+		zoneGovs      []map[string]cgschema.Value
+		broken        string
+		wantZoneQuery bool
+		wantGovs      []string
+		wantErr       error
+	}{
+		{
+			name: "governances of the resources",
+			resourceGovs: []map[string]cgschema.Value{
+				govBinding("did:key:res1", "gov1"),
+				govBinding("did:key:res2", "gov2"),
+				govBinding("did:key:res1", "other"),
+			},
+			wantGovs: []string{"gov1", "gov2", "gov1"},
+		},
+		{
+			name:          "governances inherited from zones",
+			resourceGovs:  []map[string]cgschema.Value{govBinding("did:key:res1", "gov1")},
+			zoneGovs:      []map[string]cgschema.Value{govBinding("did:key:res2", "zone"), govBinding("did:key:res2", "zone")},
+			wantZoneQuery: true,
+			wantGovs:      []string{"gov1", "zone", "gov1"},
+		},
+		{
+			name:          "governance ambiguously inherited from zones",
+			resourceGovs:  []map[string]cgschema.Value{govBinding("did:key:res1", "gov1")},
+			zoneGovs:      []map[string]cgschema.Value{govBinding("did:key:res2", "zone1"), govBinding("did:key:res2", "zone2")},
+			wantZoneQuery: true,
+			wantErr: fmt.Errorf("failed to get governance of resource did:key:res2: %w",
+				dataverse.NewDVError(dataverse.ErrAmbiguousGov, fmt.Errorf("zone1, zone2"))),
+		},
+		{
+			name:          "resource without governance",
+			resourceGovs:  []map[string]cgschema.Value{govBinding("did:key:res2", "gov2")},
+			wantZoneQuery: true,
+			wantGovs:      []string{"", "gov2", ""},
+		},
+		{
+			name: "broken governance",
+			resourceGovs: []map[string]cgschema.Value{
+				govBinding("did:key:res1", "gov1"),
+				govBinding("did:key:res2", "gov2"),
+			},
+			broken:  "gov2",
+			wantErr: dataverse.NewDVError(dataverse.ErrBrokenGov, fmt.Errorf("law-stone contract gov2")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given mocked cognitarium and law-stone clients", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				zoneQueries := 0
+				mockCognitarium := testutil.NewMockCognitariumQueryClient(controller)
+				mockCognitarium.EXPECT().
+					Store(gomock.Any(), gomock.Any()).
+					Return(&cgschema.StoreResponse{Limits: cgschema.StoreLimits{MaxQueryLimit: 30}}, nil).
+					Times(1)
+				mockCognitarium.EXPECT().
+					Select(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, msg *cgschema.QueryMsg_Select, _ ...grpc.CallOption) (*cgschema.SelectResponse, error) {
+						bindings := test.resourceGovs
+						if msg.Query.Where.Filter.Expr.And != nil {
+							zoneQueries++
+							bindings = test.zoneGovs
+						}
+						return &cgschema.SelectResponse{Results: cgschema.Results{Bindings: bindings}}, nil
+					}).
+					AnyTimes()
+
+				client := dataverse.NewDataverseQueryClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					mockCognitarium,
+					func(addr string) (lsschema.QueryClient, error) {
+						lawStoneMock := testutil.NewMockLawStoneQueryClient(controller)
+						lawStoneMock.EXPECT().
+							Ask(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, _ *lsschema.QueryMsg_Ask, _ ...grpc.CallOption) (*lsschema.AskResponse, error) {
+								if addr == test.broken {
+									return &lsschema.AskResponse{Answer: &lsschema.Answer{Results: []lsschema.Result{
+										{Error: toAddress("error(system_error(broken_law_stone),root)")},
+									}}}, nil
+								}
+								return &lsschema.AskResponse{Answer: &lsschema.Answer{Results: []lsschema.Result{{}}}}, nil
+							}).
+							AnyTimes()
+						return lawStoneMock, nil
+					},
+				)
+
+				Convey("When AskResourcesDecisions is called", func() {
+					decisions, err := client.AskResourcesDecisions(context.Background(), []dataverse.ResourcePermission{
+						{Resource: "did:key:res1", Permission: dataverse.Permission{DID: did, Action: "read"}},
+						{Resource: "did:key:res2", Permission: dataverse.Permission{DID: did, Action: "read"}},
+						{Resource: "did:key:res1", Permission: dataverse.Permission{DID: did, Action: "write"}},
+					})
+
+					Convey("Then the governances should be resolved in a query per kind of governance", func() {
+						if test.wantErr != nil {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(decisions, ShouldBeNil)
+						} else {
+							So(err, ShouldBeNil)
+							govs := make([]string, 0, len(decisions))
+							for _, decision := range decisions {
+								gov := ""
+								if decision != nil {
+									gov = decision.GovAddr
+								}
+								govs = append(govs, gov)
+							}
+							So(govs, ShouldResemble, test.wantGovs)
+						}
+						So(zoneQueries == 1, ShouldEqual, test.wantZoneQuery)
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestCachingQueryClient_AskResourcesDecisions(t *testing.T) {
+	const did = "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5"
+
+	Convey("Given resources under two governances", t, func() {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		mockClient := testutil.NewMockQueryClient(controller)
+		client := dataverse.NewCachingQueryClient(mockClient)
+
+		Convey("When AskResourcesDecisions is called", func() {
+			for resource, gov := range map[string]string{"res1": "gov1", "res2": "gov2", "res3": "gov1"} {
+				mockClient.EXPECT().GetResourceGovAddr(gomock.Any(), resource).Return(gov, nil).Times(1)
+			}
+			mockClient.EXPECT().
+				AskGovDecisions(gomock.Any(), "gov1", []dataverse.Permission{{DID: did, Action: "read"}, {DID: did, Action: "write"}}).
+				Return([]*dataverse.Decision{
+					{GovAddr: "gov1", DID: did, Action: "read", Result: prolog.Atom("permitted")},
+					{GovAddr: "gov1", DID: did, Action: "write", Result: prolog.Atom("prohibited")},
+				}, nil).
+				Times(1)
+			mockClient.EXPECT().
+				AskGovDecisions(gomock.Any(), "gov2", []dataverse.Permission{{DID: did, Action: "read"}}).
+				Return([]*dataverse.Decision{
+					{GovAddr: "gov2", DID: did, Action: "read", Result: prolog.Atom("prohibited")},
+				}, nil).
+				Times(1)
+
+			decisions, err := client.AskResourcesDecisions(context.Background(), []dataverse.ResourcePermission{
+				{Resource: "res1", Permission: dataverse.Permission{DID: did, Action: "read"}},
+				{Resource: "res2", Permission: dataverse.Permission{DID: did, Action: "read"}},
+				{Resource: "res3", Permission: dataverse.Permission{DID: did, Action: "read"}},
+				{Resource: "res1", Permission: dataverse.Permission{DID: did, Action: "write"}},
+			})
+
+			Convey("Then each governance should be queried once", func() {
+				So(err, ShouldBeNil)
+				So(decisions, ShouldHaveLength, 4)
+				So(decisions[0].GovAddr, ShouldEqual, "gov1")
+				So(decisions[0].Permitted(), ShouldBeTrue)
+				So(decisions[1].GovAddr, ShouldEqual, "gov2")
+				So(decisions[1].Permitted(), ShouldBeFalse)
+				So(decisions[2], ShouldEqual, decisions[0])
+				So(decisions[3].Action, ShouldEqual, "write")
+				So(decisions[3].Permitted(), ShouldBeFalse)
+			})
+		})
+
+		Convey("When AskResourcesDecisions is called twice", func() {
+			mockClient.EXPECT().GetResourceGovAddr(gomock.Any(), "res1").Return("gov1", nil).Times(1)
+			mockClient.EXPECT().
+				AskGovDecisions(gomock.Any(), "gov1", []dataverse.Permission{{DID: did, Action: "read"}}).
+				Return([]*dataverse.Decision{{GovAddr: "gov1", DID: did, Action: "read"}}, nil).
+				Times(2)
+
+			permissions := []dataverse.ResourcePermission{
+				{Resource: "res1", Permission: dataverse.Permission{DID: did, Action: "read"}},
+			}
+			_, errFirst := client.AskResourcesDecisions(context.Background(), permissions)
+			decisions, errSecond := client.AskResourcesDecisions(context.Background(), permissions)
+
+			Convey("Then the governance of the resources should be resolved from the cache", func() {
+				So(errFirst, ShouldBeNil)
+				So(errSecond, ShouldBeNil)
+				So(decisions, ShouldHaveLength, 1)
+				So(decisions[0].GovAddr, ShouldEqual, "gov1")
+			})
+		})
+
+		Convey("When a resource has no governance", func() {
+			mockClient.EXPECT().GetResourceGovAddr(gomock.Any(), "res1").Return("gov1", nil).Times(1)
+			mockClient.EXPECT().
+				GetResourceGovAddr(gomock.Any(), "ungoverned").
+				Return("", dataverse.NewDVError(dataverse.ErrNoResult, nil)).
+				Times(1)
+			mockClient.EXPECT().
+				AskGovDecisions(gomock.Any(), "gov1", []dataverse.Permission{{DID: did, Action: "read"}}).
+				Return([]*dataverse.Decision{{GovAddr: "gov1", DID: did, Action: "read"}}, nil).
+				Times(1)
+
+			decisions, err := client.AskResourcesDecisions(context.Background(), []dataverse.ResourcePermission{
+				{Resource: "ungoverned", Permission: dataverse.Permission{DID: did, Action: "read"}},
+				{Resource: "res1", Permission: dataverse.Permission{DID: did, Action: "read"}},
+			})
+
+			Convey("Then its decision should be nil and the others given", func() {
+				So(err, ShouldBeNil)
+				So(decisions, ShouldHaveLength, 2)
+				So(decisions[0], ShouldBeNil)
+				So(decisions[1].GovAddr, ShouldEqual, "gov1")
+			})
+		})
+
+		Convey("When the governance of a resource cannot be resolved", func() {
+			mockClient.EXPECT().GetResourceGovAddr(gomock.Any(), "unknown").Return("", fmt.Errorf("error")).Times(1)
+
+			decisions, err := client.AskResourcesDecisions(context.Background(), []dataverse.ResourcePermission{
+				{Resource: "unknown", Permission: dataverse.Permission{DID: did, Action: "read"}},
+			})
+
+			Convey("Then an error should be returned", func() {
+				So(err.Error(), ShouldEqual, "failed to get governance of resource unknown: error")
+				So(decisions, ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,418 @@
+package dataverse
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCacheSize      = 1024
+	defaultResourceGovTTL = time.Minute
+	defaultDecisionTTL    = 30 * time.Second
+	defaultGovCodeTTL     = 5 * time.Minute
+	defaultInfoTTL        = 5 * time.Minute
+)
+
+// CachingQueryClient is a QueryClient decorator caching the governance resolutions and decisions, so that the
+// resources frequently accessed are served without querying the node each time.
+//
+// Only successful responses are cached, each one for the TTL configured for its kind of query; the least recently
+// used entries are evicted once the cache is full. Queries not concerned by caching are forwarded to the decorated
+// client.
+//
+// The cache is not notified of the transactions changing the dataverse: until their TTL expires, entries are only
+// refreshed once invalidated. A client sending such transactions, e.g. submitting claims or breaking a governance,
+// should thus invalidate the entries they concern, or call ObserveHeight with the height of every new block, e.g. the
+// one of its transaction responses, not to be served stale resolutions and decisions.
+//
+// Cached values are shared between callers and must not be modified.
+type CachingQueryClient struct {
+	QueryClient
+
+	resourceGovTTL time.Duration
+	decisionTTL    time.Duration
+	govCodeTTL     time.Duration
+	infoTTL        time.Duration
+	size           int
+	now            func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	height  int64
+	// generation is incremented on every invalidation, so that the values fetched before are not cached.
+	generation uint64
+}
+
+var _ QueryClient = &CachingQueryClient{}
+
+type cacheEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+	// did and addr are the resource or identity and the governance the entry is about, if any, used for selective
+	// invalidation.
+	did  string
+	addr string
+}
+
+// CacheOption is a function to configure a CachingQueryClient.
+type CacheOption func(*CachingQueryClient)
+
+// WithResourceGovTTL sets for how long the governance address of a resource is cached. A zero TTL disables its
+// caching.
+func WithResourceGovTTL(ttl time.Duration) CacheOption {
+	return func(c *CachingQueryClient) {
+		c.resourceGovTTL = ttl
+	}
+}
+
+// WithDecisionTTL sets for how long the answers of governances about permitted actions and their state are cached. A
+// zero TTL disables their caching.
+func WithDecisionTTL(ttl time.Duration) CacheOption {
+	return func(c *CachingQueryClient) {
+		c.decisionTTL = ttl
+	}
+}
+
+// WithGovCodeTTL sets for how long the program of a governance and its location are cached. A zero TTL disables
+// their caching.
+func WithGovCodeTTL(ttl time.Duration) CacheOption {
+	return func(c *CachingQueryClient) {
+		c.govCodeTTL = ttl
+	}
+}
+
+// WithInfoTTL sets for how long the dataverse and cognitarium information are cached. A zero TTL disables their
+// caching.
+func WithInfoTTL(ttl time.Duration) CacheOption {
+	return func(c *CachingQueryClient) {
+		c.infoTTL = ttl
+	}
+}
+
+// WithCacheSize sets the maximum number of entries kept in the cache.
+func WithCacheSize(size int) CacheOption {
+	return func(c *CachingQueryClient) {
+		c.size = size
+	}
+}
+
+// NewCachingQueryClient creates a CachingQueryClient decorating the given client.
+func NewCachingQueryClient(client QueryClient, opts ...CacheOption) *CachingQueryClient {
+	c := &CachingQueryClient{
+		QueryClient:    client,
+		resourceGovTTL: defaultResourceGovTTL,
+		decisionTTL:    defaultDecisionTTL,
+		govCodeTTL:     defaultGovCodeTTL,
+		infoTTL:        defaultInfoTTL,
+		size:           defaultCacheSize,
+		now:            time.Now,
+		entries:        make(map[string]*list.Element),
+		lru:            list.New(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Close closes the underlying client if it holds a connection, as the one created by NewQueryClient does.
+func (c *CachingQueryClient) Close() error {
+	return closeClient(c.QueryClient)
+}
+
+func (c *CachingQueryClient) DataverseInfo(ctx context.Context) (*Info, error) {
+	return cached(c, cacheEntry{key: "dataverse-info"}, c.infoTTL, func() (*Info, error) {
+		return c.QueryClient.DataverseInfo(ctx)
+	})
+}
+
+func (c *CachingQueryClient) CognitariumInfo(ctx context.Context) (*CognitariumInfo, error) {
+	return cached(c, cacheEntry{key: "cognitarium-info"}, c.infoTTL, func() (*CognitariumInfo, error) {
+		return c.QueryClient.CognitariumInfo(ctx)
+	})
+}
+
+func (c *CachingQueryClient) GetResourceGovAddr(ctx context.Context, resourceDID string) (string, error) {
+	entry := cacheEntry{key: cacheKey("resource-gov", resourceDID), did: resourceDID}
+	return cached(c, entry, c.resourceGovTTL, func() (string, error) {
+		return c.QueryClient.GetResourceGovAddr(ctx, resourceDID)
+	})
+}
+
+func (c *CachingQueryClient) AskGovPermittedActions(ctx context.Context, addr, did string) ([]string, error) {
+	entry := cacheEntry{key: cacheKey("permitted-actions", addr, did), did: did, addr: addr}
+	return cached(c, entry, c.decisionTTL, func() ([]string, error) {
+		return c.QueryClient.AskGovPermittedActions(ctx, addr, did)
+	})
+}
+
+func (c *CachingQueryClient) AskGovTellAction(ctx context.Context, addr, did, action string) (bool, error) {
+	entry := cacheEntry{key: cacheKey("tell-action", addr, did, action), did: did, addr: addr}
+	return cached(c, entry, c.decisionTTL, func() (bool, error) {
+		return c.QueryClient.AskGovTellAction(ctx, addr, did, action)
+	})
+}
+
+func (c *CachingQueryClient) AskGovDecision(ctx context.Context, addr, did, action string) (*Decision, error) {
+	entry := cacheEntry{key: cacheKey("decision", addr, did, action), did: did, addr: addr}
+	return cached(c, entry, c.decisionTTL, func() (*Decision, error) {
+		return c.QueryClient.AskGovDecision(ctx, addr, did, action)
+	})
+}
+
+// AskResourcesDecisions groups the resources by governance as the decorated client does, but resolves their
+// governance through the cache.
+func (c *CachingQueryClient) AskResourcesDecisions(
+	ctx context.Context,
+	permissions []ResourcePermission,
+) ([]*Decision, error) {
+	return askResourcesDecisions(ctx, c, c, permissions)
+}
+
+// getResourcesGovAddrs resolves the governance of the given resources not cached at once if the decorated client
+// can, one by one otherwise.
+func (c *CachingQueryClient) getResourcesGovAddrs(ctx context.Context, resources []string) (map[string]string, error) {
+	caching := c.resourceGovTTL > 0 && c.size > 0
+	addrs := make(map[string]string, len(resources))
+	var missing []string
+	var generation uint64
+	for i, resource := range resources {
+		if !caching {
+			missing = append(missing, resource)
+			continue
+		}
+
+		value, gen, ok := c.get(cacheKey("resource-gov", resource))
+		if i == 0 {
+			generation = gen
+		}
+		if ok {
+			addrs[resource] = value.(string)
+		} else {
+			missing = append(missing, resource)
+		}
+	}
+
+	fetched := make(map[string]string, len(missing))
+	if resolver, ok := c.QueryClient.(govAddrsResolver); ok {
+		var err error
+		if fetched, err = resolver.getResourcesGovAddrs(ctx, missing); err != nil {
+			return nil, err
+		}
+	} else {
+		for _, resource := range missing {
+			addr, err := c.QueryClient.GetResourceGovAddr(ctx, resource)
+			if isNoResult(err) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to get governance of resource %s: %w", resource, err)
+			}
+			fetched[resource] = addr
+		}
+	}
+
+	for resource, addr := range fetched {
+		addrs[resource] = addr
+		if caching {
+			c.put(cacheEntry{
+				key:       cacheKey("resource-gov", resource),
+				value:     addr,
+				expiresAt: c.now().Add(c.resourceGovTTL),
+				did:       resource,
+			}, generation)
+		}
+	}
+
+	return addrs, nil
+}
+
+func (c *CachingQueryClient) IsGovBroken(ctx context.Context, addr string) (bool, error) {
+	entry := cacheEntry{key: cacheKey("broken", addr), addr: addr}
+	return cached(c, entry, c.decisionTTL, func() (bool, error) {
+		return c.QueryClient.IsGovBroken(ctx, addr)
+	})
+}
+
+func (c *CachingQueryClient) GovCode(ctx context.Context, addr string) (string, error) {
+	entry := cacheEntry{key: cacheKey("code", addr), addr: addr}
+	return cached(c, entry, c.govCodeTTL, func() (string, error) {
+		return c.QueryClient.GovCode(ctx, addr)
+	})
+}
+
+func (c *CachingQueryClient) GovProgram(ctx context.Context, addr string) (*Program, error) {
+	entry := cacheEntry{key: cacheKey("program", addr), addr: addr}
+	return cached(c, entry, c.govCodeTTL, func() (*Program, error) {
+		return c.QueryClient.GovProgram(ctx, addr)
+	})
+}
+
+// AnalyzeGov analyzes the governance code retrieved through the cache.
+func (c *CachingQueryClient) AnalyzeGov(ctx context.Context, addr string) (*GovReport, error) {
+	code, err := c.GovCode(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return AnalyzeGovProgram(code)
+}
+
+// Invalidate removes all the entries from the cache.
+func (c *CachingQueryClient) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clear()
+}
+
+// InvalidateResource removes from the cache all the entries about the resource identified by the given DID: its
+// governance address and, if still cached, the decisions of this governance, as the ones cached by AskGovDecision,
+// are keyed by governance and identity rather than by resource. The decisions made about the DID as an identity are
+// removed too.
+func (c *CachingQueryClient) InvalidateResource(did string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var addr string
+	if elem, ok := c.entries[cacheKey("resource-gov", did)]; ok {
+		addr, _ = elem.Value.(*cacheEntry).value.(string)
+	}
+	c.remove(func(e *cacheEntry) bool { return e.did == did || addr != "" && e.addr == addr })
+}
+
+// InvalidateGov removes from the cache all the entries about the governance at the given address, such as its
+// program or the decisions it made.
+func (c *CachingQueryClient) InvalidateGov(addr string) {
+	c.removeIf(func(e *cacheEntry) bool { return e.addr == addr })
+}
+
+// ObserveHeight notifies the cache of the current block height of the chain. The whole cache is invalidated when a
+// new block height is observed, as the block may have changed any claim or governance.
+func (c *CachingQueryClient) ObserveHeight(height int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if height <= c.height {
+		return
+	}
+	c.height = height
+	c.clear()
+}
+
+// Len returns the number of entries in the cache, expired ones included.
+func (c *CachingQueryClient) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+// cached returns the value of the given entry if present and not expired, otherwise it fetches it and caches it for
+// the given TTL, unless an error occurred or the cache has been invalidated in the meantime.
+func cached[T any](c *CachingQueryClient, entry cacheEntry, ttl time.Duration, fetch func() (T, error)) (T, error) {
+	if ttl <= 0 || c.size <= 0 {
+		return fetch()
+	}
+
+	value, generation, ok := c.get(entry.key)
+	if ok {
+		return value.(T), nil
+	}
+
+	fetched, err := fetch()
+	if err != nil {
+		return fetched, err
+	}
+
+	entry.value = fetched
+	entry.expiresAt = c.now().Add(ttl)
+	c.put(entry, generation)
+
+	return fetched, nil
+}
+
+// get returns the value of the entry with the given key if present and not expired, along with the current
+// generation of the cache.
+func (c *CachingQueryClient) get(key string) (interface{}, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, c.generation, false
+	}
+
+	entry := elem.Value.(*cacheEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		return nil, c.generation, false
+	}
+
+	c.lru.MoveToFront(elem)
+	return entry.value, c.generation, true
+}
+
+// put caches the given entry, unless the cache has been invalidated since the given generation, the value of the
+// entry being possibly fetched before the invalidation.
+func (c *CachingQueryClient) put(entry cacheEntry, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	if elem, ok := c.entries[entry.key]; ok {
+		elem.Value = &entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.entries[entry.key] = c.lru.PushFront(&entry)
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (c *CachingQueryClient) removeIf(match func(*cacheEntry) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.remove(match)
+}
+
+// remove removes the entries matching the given function, the caller holding the lock.
+func (c *CachingQueryClient) remove(match func(*cacheEntry) bool) {
+	c.generation++
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		if entry := elem.Value.(*cacheEntry); match(entry) {
+			c.lru.Remove(elem)
+			delete(c.entries, entry.key)
+		}
+		elem = next
+	}
+}
+
+// clear removes all the entries, the caller holding the lock.
+func (c *CachingQueryClient) clear() {
+	c.generation++
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+}
+
+// cacheKey joins the given parts in a key, separated by a character that cannot appear in a DID nor an address.
+func cacheKey(parts ...string) string {
+	return strings.Join(parts, "\x00")
+}
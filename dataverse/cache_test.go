@@ -0,0 +1,223 @@
+package dataverse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/prolog"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCachingQueryClient(t *testing.T) {
+	const (
+		did1 = "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5"
+		did2 = "did:key:zQ3shpoUHzwcgdt2gxjqHHnJnNkBVd4uX3ZBhmPiM7J93yqCr"
+	)
+
+	Convey("Given a caching client decorating a mocked client", t, func() {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockClient := testutil.NewMockQueryClient(controller)
+		client := dataverse.NewCachingQueryClient(
+			mockClient,
+			dataverse.WithResourceGovTTL(time.Minute),
+			dataverse.WithDecisionTTL(10*time.Second),
+			dataverse.WithCacheSize(2),
+			dataverse.WithClock(func() time.Time { return now }),
+		)
+
+		Convey("When the governance address of a resource is asked twice", func() {
+			mockClient.EXPECT().GetResourceGovAddr(gomock.Any(), did1).Return("axone1gov", nil).Times(1)
+
+			addr1, err1 := client.GetResourceGovAddr(context.Background(), did1)
+			addr2, err2 := client.GetResourceGovAddr(context.Background(), did1)
+
+			Convey("Then the node should be queried only once", func() {
+				So(err1, ShouldBeNil)
+				So(err2, ShouldBeNil)
+				So(addr1, ShouldEqual, "axone1gov")
+				So(addr2, ShouldEqual, "axone1gov")
+			})
+		})
+
+		Convey("When a decision is asked again after its TTL", func() {
+			decision := &dataverse.Decision{GovAddr: "axone1gov", DID: did1, Action: "read", Result: prolog.Atom("permitted")}
+			mockClient.EXPECT().AskGovDecision(gomock.Any(), "axone1gov", did1, "read").Return(decision, nil).Times(2)
+
+			_, err := client.AskGovDecision(context.Background(), "axone1gov", did1, "read")
+			So(err, ShouldBeNil)
+			now = now.Add(5 * time.Second)
+			_, err = client.AskGovDecision(context.Background(), "axone1gov", did1, "read")
+			So(err, ShouldBeNil)
+			now = now.Add(5 * time.Second)
+			result, err := client.AskGovDecision(context.Background(), "axone1gov", did1, "read")
+
+			Convey("Then the node should be queried again", func() {
+				So(err, ShouldBeNil)
+				So(result, ShouldEqual, decision)
+			})
+		})
+
+		Convey("When a query fails", func() {
+			mockClient.EXPECT().
+				AskGovTellAction(gomock.Any(), "axone1gov", did1, "read").
+				Return(false, fmt.Errorf("error")).
+				Times(1)
+			mockClient.EXPECT().
+				AskGovTellAction(gomock.Any(), "axone1gov", did1, "read").
+				Return(true, nil).
+				Times(1)
+
+			_, err := client.AskGovTellAction(context.Background(), "axone1gov", did1, "read")
+			So(err, ShouldNotBeNil)
+			ok, err := client.AskGovTellAction(context.Background(), "axone1gov", did1, "read")
+
+			Convey("Then the error should not be cached", func() {
+				So(err, ShouldBeNil)
+				So(ok, ShouldBeTrue)
+				So(client.Len(), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When more entries than the cache size are cached", func() {
+			mockClient.EXPECT().GetResourceGovAddr(gomock.Any(), did1).Return("axone1gov1", nil).Times(2)
+			mockClient.EXPECT().GetResourceGovAddr(gomock.Any(), did2).Return("axone1gov2", nil).Times(1)
+			mockClient.EXPECT().GovCode(gomock.Any(), "axone1gov1").Return("code", nil).Times(1)
+
+			_, _ = client.GetResourceGovAddr(context.Background(), did1)
+			_, _ = client.GetResourceGovAddr(context.Background(), did2)
+			_, _ = client.GetResourceGovAddr(context.Background(), did2)
+			_, _ = client.GovCode(context.Background(), "axone1gov1")
+			_, _ = client.GetResourceGovAddr(context.Background(), did1)
+
+			Convey("Then the least recently used entries should be evicted", func() {
+				So(client.Len(), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When entries about a resource or a governance are invalidated", func() {
+			mockClient.EXPECT().GetResourceGovAddr(gomock.Any(), did1).Return("axone1gov1", nil).Times(2)
+			mockClient.EXPECT().AskGovPermittedActions(gomock.Any(), "axone1gov", did2).Return([]string{"read"}, nil).Times(2)
+
+			_, _ = client.GetResourceGovAddr(context.Background(), did1)
+			_, _ = client.AskGovPermittedActions(context.Background(), "axone1gov", did2)
+			client.InvalidateResource(did1)
+			So(client.Len(), ShouldEqual, 1)
+			client.InvalidateGov("axone1gov")
+			So(client.Len(), ShouldEqual, 0)
+
+			_, err1 := client.GetResourceGovAddr(context.Background(), did1)
+			actions, err2 := client.AskGovPermittedActions(context.Background(), "axone1gov", did2)
+
+			Convey("Then the node should be queried again", func() {
+				So(err1, ShouldBeNil)
+				So(err2, ShouldBeNil)
+				So(actions, ShouldResemble, []string{"read"})
+			})
+		})
+
+		Convey("When a resource whose governance made decisions is invalidated", func() {
+			mockClient.EXPECT().GetResourceGovAddr(gomock.Any(), did1).Return("axone1gov", nil).Times(2)
+			mockClient.EXPECT().AskGovDecision(gomock.Any(), "axone1gov", did2, "read").
+				Return(&dataverse.Decision{Result: dataverse.PermittedResult}, nil).Times(2)
+
+			_, _ = client.GetResourceGovAddr(context.Background(), did1)
+			_, _ = client.AskGovDecision(context.Background(), "axone1gov", did2, "read")
+			client.InvalidateResource(did1)
+			So(client.Len(), ShouldEqual, 0)
+
+			_, err1 := client.GetResourceGovAddr(context.Background(), did1)
+			decision, err2 := client.AskGovDecision(context.Background(), "axone1gov", did2, "read")
+
+			Convey("Then the decisions of its governance should be asked again", func() {
+				So(err1, ShouldBeNil)
+				So(err2, ShouldBeNil)
+				So(decision.Permitted(), ShouldBeTrue)
+			})
+		})
+
+		Convey("When new block heights are observed", func() {
+			mockClient.EXPECT().IsGovBroken(gomock.Any(), "axone1gov").Return(false, nil).Times(2)
+
+			client.ObserveHeight(10)
+			_, _ = client.IsGovBroken(context.Background(), "axone1gov")
+			client.ObserveHeight(10)
+			client.ObserveHeight(9)
+			_, _ = client.IsGovBroken(context.Background(), "axone1gov")
+			client.ObserveHeight(11)
+			So(client.Len(), ShouldEqual, 0)
+			_, _ = client.IsGovBroken(context.Background(), "axone1gov")
+
+			Convey("Then the cache should be invalidated on each new height only", func() {
+				So(client.Len(), ShouldEqual, 1)
+				client.Invalidate()
+				So(client.Len(), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When the cache is invalidated while a value is fetched", func() {
+			gomock.InOrder(
+				mockClient.EXPECT().GetResourceGovAddr(gomock.Any(), did1).
+					DoAndReturn(func(_ context.Context, _ string) (string, error) {
+						client.InvalidateResource(did1)
+						return "axone1old", nil
+					}),
+				mockClient.EXPECT().GetResourceGovAddr(gomock.Any(), did1).
+					DoAndReturn(func(_ context.Context, _ string) (string, error) {
+						client.ObserveHeight(12)
+						return "axone1old", nil
+					}),
+				mockClient.EXPECT().GetResourceGovAddr(gomock.Any(), did1).Return("axone1new", nil),
+			)
+
+			first, err1 := client.GetResourceGovAddr(context.Background(), did1)
+			second, err2 := client.GetResourceGovAddr(context.Background(), did1)
+			third, err3 := client.GetResourceGovAddr(context.Background(), did1)
+			fourth, err4 := client.GetResourceGovAddr(context.Background(), did1)
+
+			Convey("Then the value fetched before the invalidation should not be cached", func() {
+				So(err1, ShouldBeNil)
+				So(err2, ShouldBeNil)
+				So(err3, ShouldBeNil)
+				So(err4, ShouldBeNil)
+				So(first, ShouldEqual, "axone1old")
+				So(second, ShouldEqual, "axone1old")
+				So(third, ShouldEqual, "axone1new")
+				So(fourth, ShouldEqual, "axone1new")
+			})
+		})
+
+		Convey("When caching is disabled for a kind of query", func() {
+			client := dataverse.NewCachingQueryClient(mockClient, dataverse.WithGovCodeTTL(0))
+			mockClient.EXPECT().GovCode(gomock.Any(), "axone1gov").Return("code", nil).Times(2)
+
+			_, _ = client.GovCode(context.Background(), "axone1gov")
+			code, err := client.GovCode(context.Background(), "axone1gov")
+
+			Convey("Then the node should be queried each time", func() {
+				So(err, ShouldBeNil)
+				So(code, ShouldEqual, "code")
+				So(client.Len(), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When a query not concerned by caching is made", func() {
+			mockClient.EXPECT().GetZoneMembers(gomock.Any(), did1).Return([]string{did2}, nil).Times(2)
+
+			_, _ = client.GetZoneMembers(context.Background(), did1)
+			members, err := client.GetZoneMembers(context.Background(), did1)
+
+			Convey("Then it should be forwarded to the decorated client", func() {
+				So(err, ShouldBeNil)
+				So(members, ShouldResemble, []string{did2})
+			})
+		})
+	})
+}
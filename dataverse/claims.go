@@ -0,0 +1,150 @@
+package dataverse
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+)
+
+// VerifiableCredentialType is the IRI of the base type shared by all the verifiable credentials.
+const VerifiableCredentialType = "https://www.w3.org/2018/credentials#VerifiableCredential"
+
+// ClaimRecord is a credential recorded in the dataverse along with the properties it claims about its subject.
+type ClaimRecord struct {
+	// CredentialID is the identifier of the credential.
+	CredentialID string
+	// Type is the IRI of the credential type.
+	Type string
+	// Issuer of the credential.
+	Issuer string
+	// IssuanceDate of the credential.
+	IssuanceDate time.Time
+	// Properties claimed about the subject, values are indexed by their property IRI. Values are either IRIs, literals
+	// lexical forms or `_:` prefixed blank node labels.
+	Properties map[string][]string
+}
+
+func (c *queryClient) GetSubjectClaims(ctx context.Context, subjectDID string) (map[string][]ClaimRecord, error) {
+	maxLimit, err := c.maxQueryLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.cognitariumClient.Select(ctx, &cgschema.QueryMsg_Select{
+		Query: buildSubjectCredentialsRequest(subjectDID, maxLimit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Results.Bindings) >= maxLimit {
+		return nil, NewDVError(ErrQueryLimit, fmt.Errorf("more than %d credential types about %s", maxLimit, subjectDID))
+	}
+
+	// A credential is returned once per type, its claimed properties are retrieved once for all of them.
+	records := make([]ClaimRecord, 0, len(response.Results.Bindings))
+	credIDs := make([]string, 0, len(response.Results.Bindings))
+	for _, binding := range response.Results.Bindings {
+		record, err := claimRecordFromBinding(binding)
+		if err != nil {
+			return nil, err
+		}
+		if record.Type == VerifiableCredentialType {
+			continue
+		}
+
+		if !slices.Contains(credIDs, record.CredentialID) {
+			credIDs = append(credIDs, record.CredentialID)
+		}
+		records = append(records, record)
+	}
+
+	claims := make(map[string][]ClaimRecord)
+	if len(records) == 0 {
+		return claims, nil
+	}
+
+	properties, err := c.credentialsClaims(ctx, credIDs, maxLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		for _, property := range properties[record.CredentialID] {
+			record.Properties[property.predicate] = append(record.Properties[property.predicate], property.value)
+		}
+		claims[record.Type] = append(claims[record.Type], record)
+	}
+
+	return claims, nil
+}
+
+func claimRecordFromBinding(binding map[string]cgschema.Value) (ClaimRecord, error) {
+	credID, err := bindingIRI(binding, "credId")
+	if err != nil {
+		return ClaimRecord{}, err
+	}
+	credType, err := bindingIRI(binding, "type")
+	if err != nil {
+		return ClaimRecord{}, err
+	}
+	issuer, err := bindingIRI(binding, "issuer")
+	if err != nil {
+		return ClaimRecord{}, err
+	}
+	issuanceDate, err := bindingDateTime(binding, "issuedAt")
+	if err != nil {
+		return ClaimRecord{}, err
+	}
+
+	return ClaimRecord{
+		CredentialID: credID,
+		Type:         credType,
+		Issuer:       issuer,
+		IssuanceDate: issuanceDate,
+		Properties:   make(map[string][]string),
+	}, nil
+}
+
+// claimProperty is a property claimed by a credential, along with its value.
+type claimProperty struct {
+	predicate string
+	value     string
+}
+
+// credentialsClaims retrieves the properties claimed by the given credentials, indexed by credential ID. They are
+// retrieved in a single select unless the maximum query limit of the cognitarium is reached, in which case the
+// credentials are split until their properties fit.
+func (c *queryClient) credentialsClaims(
+	ctx context.Context,
+	credIDs []string,
+	maxLimit int,
+) (map[string][]claimProperty, error) {
+	bindings, err := c.selectByIRIs(ctx, credIDs, maxLimit, buildCredentialsClaimRequest, func(credID string) error {
+		return NewDVError(ErrQueryLimit, fmt.Errorf("more than %d properties claimed by %s", maxLimit, credID))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claims := make(map[string][]claimProperty, len(credIDs))
+	for _, binding := range bindings {
+		credID, err := bindingIRI(binding, "credId")
+		if err != nil {
+			return nil, err
+		}
+		predicate, err := bindingIRI(binding, "p")
+		if err != nil {
+			return nil, err
+		}
+		value, err := bindingString(binding, "o")
+		if err != nil {
+			return nil, err
+		}
+		claims[credID] = append(claims[credID], claimProperty{predicate: predicate, value: value})
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,215 @@
+package dataverse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	govTextNS       = "https://w3id.org/axone/ontology/v4/schema/credential/governance/text/"
+	datasetCredType = datasetNS + "DatasetDescriptionCredential"
+	govCredType     = govTextNS + "GovernanceTextCredential"
+)
+
+func credentialBinding(credID, credType, issuedAt string) map[string]cgschema.Value {
+	return map[string]cgschema.Value{
+		"credId":   uriValue(credID),
+		"type":     uriValue(credType),
+		"issuer":   uriValue("did:key:issuer"),
+		"issuedAt": literalValue(issuedAt),
+	}
+}
+
+func TestClient_GetSubjectClaims(t *testing.T) {
+	tests := []struct {
+		name          string
+		bindings      []map[string]cgschema.Value
+		claims        map[string][]map[string]cgschema.Value
+		responseError error
+		maxQueryLimit int
+		wantErr       error
+		wantSelects   int
+		wantResult    map[string][]dataverse.ClaimRecord
+	}{
+		{
+			name: "credentials grouped by type",
+			bindings: []map[string]cgschema.Value{
+				credentialBinding("cred:1", dataverse.VerifiableCredentialType, "2024-01-01T00:00:00Z"),
+				credentialBinding("cred:1", datasetCredType, "2024-01-01T00:00:00Z"),
+				credentialBinding("cred:2", govCredType, "2024-02-01T00:00:00Z"),
+				credentialBinding("cred:2", datasetCredType, "2024-02-01T00:00:00Z"),
+				credentialBinding("cred:3", datasetCredType, "2024-03-01T00:00:00Z"),
+			},
+			wantSelects: 2,
+			claims: map[string][]map[string]cgschema.Value{
+				"cred:1": {
+					{"p": uriValue(datasetNS + "hasTitle"), "o": literalValue("title")},
+					{"p": uriValue(datasetNS + "hasTag"), "o": literalValue("tag1")},
+					{"p": uriValue(datasetNS + "hasTag"), "o": literalValue("tag2")},
+				},
+				"cred:2": {
+					{"p": uriValue(govTextNS + "isGovernedBy"), "o": cgschema.Value{ValueType: cgschema.BlankNode{Type: "blank_node", Value: "b0"}}},
+				},
+			},
+			wantResult: map[string][]dataverse.ClaimRecord{
+				datasetCredType: {
+					{
+						CredentialID: "cred:1",
+						Type:         datasetCredType,
+						Issuer:       "did:key:issuer",
+						IssuanceDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						Properties: map[string][]string{
+							datasetNS + "hasTitle": {"title"},
+							datasetNS + "hasTag":   {"tag1", "tag2"},
+						},
+					},
+					{
+						CredentialID: "cred:2",
+						Type:         datasetCredType,
+						Issuer:       "did:key:issuer",
+						IssuanceDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+						Properties: map[string][]string{
+							govTextNS + "isGovernedBy": {"_:b0"},
+						},
+					},
+					{
+						CredentialID: "cred:3",
+						Type:         datasetCredType,
+						Issuer:       "did:key:issuer",
+						IssuanceDate: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+						Properties:   map[string][]string{},
+					},
+				},
+				govCredType: {
+					{
+						CredentialID: "cred:2",
+						Type:         govCredType,
+						Issuer:       "did:key:issuer",
+						IssuanceDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+						Properties: map[string][]string{
+							govTextNS + "isGovernedBy": {"_:b0"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "no credential",
+			bindings:    []map[string]cgschema.Value{},
+			wantSelects: 1,
+			wantResult:  map[string][]dataverse.ClaimRecord{},
+		},
+		{
+			name: "credentials exceeding the query limit",
+			bindings: []map[string]cgschema.Value{
+				credentialBinding("cred:1", dataverse.VerifiableCredentialType, "2024-01-01T00:00:00Z"),
+				credentialBinding("cred:1", datasetCredType, "2024-01-01T00:00:00Z"),
+			},
+			maxQueryLimit: 2,
+			wantErr: dataverse.NewDVError(dataverse.ErrQueryLimit,
+				fmt.Errorf("more than 2 credential types about did:key:subject")),
+			wantSelects: 1,
+		},
+		{
+			name:          "grpc error",
+			responseError: fmt.Errorf("gRPC: connection refused"),
+			wantErr:       fmt.Errorf("gRPC: connection refused"),
+			wantSelects:   1,
+		},
+		{
+			name: "invalid issuance date",
+			bindings: []map[string]cgschema.Value{
+				credentialBinding("cred:1", datasetCredType, "yesterday"),
+			},
+			wantErr:     dataverse.NewDVError(dataverse.ErrType, fmt.Errorf("expected RFC3339 date, got yesterday")),
+			wantSelects: 1,
+		},
+		{
+			name: "invalid issuer type",
+			bindings: []map[string]cgschema.Value{
+				{
+					"credId":   uriValue("cred:1"),
+					"type":     uriValue(datasetCredType),
+					"issuer":   literalValue("did:key:issuer"),
+					"issuedAt": literalValue("2024-01-01T00:00:00Z"),
+				},
+			},
+			wantErr:     dataverse.NewDVError(dataverse.ErrType, fmt.Errorf("expected URI, got %T", cgschema.Value_Literal{})),
+			wantSelects: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a mocked cognitarium client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				maxQueryLimit := test.maxQueryLimit
+				if maxQueryLimit == 0 {
+					maxQueryLimit = 30
+				}
+				selects := 0
+				mockCognitarium := testutil.NewMockCognitariumQueryClient(controller)
+				mockCognitarium.
+					EXPECT().
+					Store(gomock.Any(), gomock.Any()).
+					Return(&cgschema.StoreResponse{Limits: cgschema.StoreLimits{MaxQueryLimit: maxQueryLimit}}, nil).
+					AnyTimes()
+				mockCognitarium.
+					EXPECT().
+					Select(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *cgschema.QueryMsg_Select, _ ...any) (*cgschema.SelectResponse, error) {
+						selects++
+						if req.Query.Where.Filter != nil {
+							bindings := []map[string]cgschema.Value{}
+							for _, alternative := range *req.Query.Where.Filter.Expr.Or {
+								credID := string(*alternative.Equal.F1.NamedNode.Full)
+								for _, claim := range test.claims[credID] {
+									bindings = append(bindings, map[string]cgschema.Value{"credId": uriValue(credID), "p": claim["p"], "o": claim["o"]})
+								}
+							}
+							return &cgschema.SelectResponse{Results: cgschema.Results{Bindings: bindings}}, nil
+						}
+
+						So(string(*req.Query.Where.Bgp.Patterns[0].Object.Node.NamedNode.Full), ShouldEqual, "did:key:subject")
+						So(*req.Query.Limit, ShouldEqual, maxQueryLimit)
+						if test.responseError != nil {
+							return nil, test.responseError
+						}
+						return &cgschema.SelectResponse{Results: cgschema.Results{Bindings: test.bindings}}, nil
+					}).
+					AnyTimes()
+
+				client := dataverse.NewDataverseQueryClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					mockCognitarium,
+					nil,
+				)
+
+				Convey("When GetSubjectClaims is called", func() {
+					claims, err := client.GetSubjectClaims(context.Background(), "did:key:subject")
+
+					Convey("Then the claims grouped by credential type should be returned", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(claims, ShouldResemble, test.wantResult)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(claims, ShouldBeNil)
+						}
+						So(selects, ShouldEqual, test.wantSelects)
+					})
+				})
+			})
+		})
+	}
+}
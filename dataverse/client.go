@@ -3,6 +3,7 @@ package dataverse
 import (
 	"context"
 	"fmt"
+	"io"
 
 	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
 	dvschema "github.com/axone-protocol/axone-contract-schema/go/dataverse-schema/v6"
@@ -12,7 +13,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/piprate/json-gold/ld"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type QueryClient interface {
@@ -25,8 +28,19 @@ type QueryClient interface {
 	// GetResourceGovAddr returns the governance address of a resource.
 	// It queries the cognitarium to get the governance address (law-stone contract address)
 	// of a resource. The resource is identified by its DID.
+	// If the resource has no governance of its own, it inherits the governance of the zones it is a member of, as
+	// claimed by a ZoneMembershipCredential issued by the zone or by the resource itself; an error is returned if its
+	// zones do not share the same governance.
+	// The governance is not asked whether it is still active: a broken governance is reported by the ErrBrokenGov
+	// error of the queries then asked to it, such as AskGovDecision, or on demand by IsGovBroken.
 	GetResourceGovAddr(context.Context, string) (string, error)
 
+	// AskGov asks a Prolog query to the governance (law-stone contract) at the given address and returns its answer,
+	// the variable substitutions being parsed as Prolog terms.
+	// If the law-stone contract has been broken, an ErrBrokenGov error is returned, as for all the other governance
+	// queries relying on it.
+	AskGov(context.Context, string, string) (*GovAnswer, error)
+
 	// AskGovPermittedActions returns the permitted actions for a resource identified by its DID.
 	// It queries the law-stone contract to get the permitted actions for a resource using the following predicate:
 	// ```prolog
@@ -42,14 +56,141 @@ type QueryClient interface {
 	// The function returns true if Result is 'permitted', false otherwise.
 	AskGovTellAction(context.Context, string, string, string) (bool, error)
 
+	// AskGovDecision queries the law-stone contract to get its decision about a given action for a resource, along
+	// with the evidence justifying it. It uses the same predicate as AskGovTellAction and, like it, only considers an
+	// answer made of exactly one result: the decision has no result, so that the action is not permitted, otherwise.
+	AskGovDecision(context.Context, string, string, string) (*Decision, error)
+
+	// AskGovDecisions queries the law-stone contract at the given address to get its decisions about many actions in
+	// a single query, combining the tell/4 predicate calls of all the given permissions:
+	// ```prolog
+	// findall(Result-Evidence, tell(DID, Action, Result, Evidence), Decisions0), ...
+	// ```
+	// The permissions are asked by chunks of 25 so that each query stays within the gas limit of the contract. As for
+	// AskGovDecision, a permission gets no decision if the tell/4 predicate has no or several solutions. The decisions
+	// are returned in the order of the permissions.
+	AskGovDecisions(context.Context, string, []Permission) ([]*Decision, error)
+
+	// AskResourcesDecisions gets the decisions about actions asked to be performed on many resources. The governances
+	// of the resources are resolved as GetResourceGovAddr does, but in a single select query for the governances of
+	// the resources and another one for the ones inherited from their zones. The resources are then grouped by the
+	// governance they are under, so that each governance is queried only once through AskGovDecisions. The decisions
+	// are returned in the order of the given permissions, the ones about a resource without governance being nil.
+	AskResourcesDecisions(context.Context, []ResourcePermission) ([]*Decision, error)
+
 	// GovCode retrieves the governance code given its address (law-stone contract address)
 	GovCode(context.Context, string) (string, error)
+
+	// AnalyzeGov analyzes the code of the governance at the given address to report the actions its tell/4 and
+	// tell_permitted_actions/2 rules are about, under which conditions, and the predicates they depend on.
+	// See AnalyzeGovProgram.
+	AnalyzeGov(context.Context, string) (*GovReport, error)
+
+	// GovProgram returns the location of the governance program in the objectarium contract storing it, given the
+	// governance address (law-stone contract address).
+	GovProgram(context.Context, string) (*Program, error)
+
+	// IsGovBroken tells if the governance at the given address has been broken, in which case it is no longer active
+	// and the resources referring to it have no effective governance.
+	IsGovBroken(context.Context, string) (bool, error)
+
+	// DescribeResource returns the description of all the claims made about a resource identified by its DID, as an
+	// RDF graph serialized in the given format. It relies on the cognitarium DESCRIBE query.
+	DescribeResource(context.Context, string, RDFFormat) ([]byte, error)
+
+	// ConstructResource returns an RDF graph, serialized in the given format, having the resource identified by its DID
+	// as subject of all the properties claimed about it. It relies on the cognitarium CONSTRUCT query.
+	ConstructResource(context.Context, string, RDFFormat) ([]byte, error)
+
+	// SearchDatasets returns the page of datasets whose DatasetDescriptionCredential matches the given filter.
+	// The search is bounded by the maximum number of results the cognitarium is allowed to return in a single query,
+	// which the title, matched locally, applies to before filtering; the returned page tells when it is reached.
+	SearchDatasets(context.Context, DatasetFilter, Pagination) (*DatasetPage, error)
+
+	// GetSubjectClaims returns all the credentials recorded in the dataverse having the given DID as subject, grouped
+	// by credential type IRI. The base verifiable credential type is not considered as a group. An ErrQueryLimit
+	// error is returned if the credentials do not fit in the maximum query limit of the cognitarium.
+	GetSubjectClaims(context.Context, string) (map[string][]ClaimRecord, error)
+
+	// GetResourcePublications returns all the locations where a resource identified by its DID has been published.
+	// It queries the cognitarium for the DigitalResourcePublicationCredential having the resource as subject. An
+	// ErrQueryLimit error is returned if the publications do not fit in the maximum query limit of the cognitarium.
+	GetResourcePublications(context.Context, string) ([]Publication, error)
+
+	// GetZoneMembers returns the DIDs of the resources member of a zone identified by its DID, as claimed by
+	// ZoneMembershipCredential. An ErrQueryLimit error is returned if the memberships do not fit in the maximum query
+	// limit of the cognitarium, rather than leaving members out.
+	GetZoneMembers(context.Context, string) ([]string, error)
+
+	// FetchBindings returns the bindings of a select query on the cognitarium in a single request. It is a bounded fetch
+	// rather than a paginated stream, the cognitarium having neither offset nor ordering to page with: a query without
+	// limit, or with a limit above the maximum query limit of the cognitarium, is bounded by the latter, and an
+	// ErrQueryLimit error is returned once it is reached with bindings possibly left, rather than leaving them out.
+	FetchBindings(context.Context, cgschema.SelectQuery) ([]map[string]cgschema.Value, error)
 }
 
 type TxClient interface {
-	// SubmitClaims submits a verifiable credential to the dataverse contract.
+	// SubmitClaims submits a verifiable credential to the dataverse contract, as canonical claims given by
+	// CanonicalClaims, so that they can be identified by the hash HashClaims gives. With the WithClaimsHash option,
+	// this hash is computed from the claims actually submitted and set once the submission succeeded.
 	// Credential must be signed to be submitted.
-	SubmitClaims(ctx context.Context, credential *verifiable.Credential) (*types.TxResponse, error)
+	//
+	// With the WithValidation option, the claims are validated against the ClaimShapes beforehand, so that a
+	// credential not conforming to the ontology is rejected without any transaction; the returned error then wraps a
+	// shacl.ValidationError. Without it, the claims are submitted as is, the dataverse contract only checking their
+	// structure.
+	//
+	// With the WithSimulation option, the submission transaction is only simulated to tell if the dataverse contract
+	// would accept the credential, without committing it. The returned transaction response then only holds the gas
+	// wanted and used along with the emitted events, an error being returned if the contract rejects the claims.
+	SubmitClaims(ctx context.Context, credential *verifiable.Credential, opts ...SubmitOption) (*types.TxResponse, error)
+
+	// RevokeClaims revokes a verifiable credential previously submitted to the dataverse contract, given its identifier.
+	RevokeClaims(ctx context.Context, credentialID string) (*types.TxResponse, error)
+
+	// BreakGov breaks the governance (law-stone contract) at the given address, unpinning its program from the
+	// objectarium contract. Once broken, the governance no longer answers any query.
+	// Only the creator of the law-stone contract, i.e. the sender of its instantiation, is allowed to break it.
+	BreakGov(ctx context.Context, addr string) (*types.TxResponse, error)
+
+	// DeployGovernance puts a resource under a new governance. It instantiates a law-stone contract carrying the
+	// Prolog program of the given spec and waits for the instantiation to be included in a block to get the contract
+	// address. It then issues the credential linking the resource to this address as described by the given function,
+	// signs it with the client signer and submits it. For instance:
+	//
+	//	deployment, err := client.DeployGovernance(ctx, spec, func(addr string) credential.Descriptor {
+	//		return template.NewGovernance(resourceDID, "contract:law-stone:"+addr)
+	//	}, documentLoader)
+	//
+	// The signer is thus expected to be entitled to make claims about the resource. If the law-stone contract has been
+	// instantiated but the credential could not be issued or submitted, the returned deployment holds the address of
+	// the instantiated governance along with the error.
+	//
+	// The tx client must implement tx.Getter to wait for the instantiation, as the one created by tx.NewClient does.
+	DeployGovernance(
+		ctx context.Context,
+		spec GovernanceSpec,
+		descriptor GovernanceDescriptorFunc,
+		documentLoader ld.DocumentLoader,
+	) (*GovernanceDeployment, error)
+}
+
+// CognitariumTxClient maintains the data of a cognitarium contract, e.g. auxiliary ontology data kept alongside the
+// dataverse claims. Only the owner of the cognitarium contract is allowed to insert or delete data.
+type CognitariumTxClient interface {
+	// InsertData inserts the RDF triples serialized in the given format into the cognitarium contract.
+	// Supported formats are N-Triples, Turtle and RDF/XML.
+	InsertData(ctx context.Context, data []byte, format RDFFormat) (*types.TxResponse, error)
+
+	// DeleteData deletes from the cognitarium contract the triples built from the given templates for each solution
+	// of the where patterns. Without any where pattern, the templates must not contain variables and denote the
+	// exact triples to delete; without any template, the triples matching the where patterns are deleted.
+	// For instance, to delete all the labels of a class:
+	//
+	//	client.DeleteData(ctx, nil, []TriplePattern{
+	//		{Subject: IRI("https://example.org/Class"), Predicate: IRI("http://www.w3.org/2000/01/rdf-schema#label"), Object: Var("label")},
+	//	})
+	DeleteData(ctx context.Context, templates []TriplePattern, where []TriplePattern) (*types.TxResponse, error)
 }
 
 type LawStoneFactory func(string) (lsschema.QueryClient, error)
@@ -63,38 +204,59 @@ type queryClient struct {
 	dataverseClient   dvschema.QueryClient
 	cognitariumClient cgschema.QueryClient
 	lawStoneFactory   LawStoneFactory
+
+	// conn is the connection owned by the client, closed with it; nil if the connection is given by the caller.
+	conn io.Closer
 }
 
+// Close closes the gRPC connection of a client created by NewQueryClient, a client created on a given connection
+// leaving it open. The client must not be used once closed.
+func (q *queryClient) Close() error {
+	if q.conn == nil {
+		return nil
+	}
+	return q.conn.Close()
+}
+
+// NewQueryClient creates a QueryClient of the dataverse contract at the given address, querying the contracts through
+// a single gRPC connection to the node at the given address, established with the given options. Without option, the
+// connection is not secured. The returned client implements io.Closer to close this connection.
+//
+// The connection is created with grpc.NewClient rather than grpc.Dial, so that the address is resolved with the "dns"
+// resolver by default instead of being passed as is to the dialer; a custom resolver can still be set in the address,
+// e.g. "passthrough:///localhost:9090".
 func NewQueryClient(
 	ctx context.Context,
 	grpcAddr, contractAddr string,
 	opts ...grpc.DialOption,
 ) (QueryClient, error) {
-	dataverseClient, err := dvschema.NewQueryClient(grpcAddr, contractAddr, opts...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create dataverse client: %w", err)
+	if len(opts) == 0 {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
 
-	cognitariumAddr, err := getCognitariumAddr(ctx, dataverseClient)
+	conn, err := grpc.NewClient(grpcAddr, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get cognitarium address: %w", err)
+		return nil, fmt.Errorf("failed to create dataverse client: %w", err)
 	}
 
-	cognitariumClient, err := cgschema.NewQueryClient(grpcAddr, cognitariumAddr, opts...)
+	client, err := NewQueryClientFromConn(ctx, conn, contractAddr)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create cognitarium client: %w", err)
+		_ = conn.Close()
+		return nil, err
 	}
+	client.(*queryClient).conn = conn
 
-	return &queryClient{
-		contractAddr,
-		cognitariumAddr,
+	return client, nil
+}
 
-		dataverseClient,
-		cognitariumClient,
-		func(addr string) (lsschema.QueryClient, error) {
-			return lsschema.NewQueryClient(grpcAddr, addr, opts...)
-		},
-	}, nil
+// NewQueryClientFromConn creates a QueryClient of the dataverse contract at the given address, querying the contracts
+// through the given gRPC connection, e.g. one shared with other clients of the node.
+func NewQueryClientFromConn(ctx context.Context, conn grpc.ClientConnInterface, contractAddr string) (QueryClient, error) {
+	querier := &grpcQuerier{conn: conn}
+
+	return newContractQueryClient(ctx, querier, contractAddr, func(addr string) (lsschema.QueryClient, error) {
+		return &contractLawStoneClient{querier, addr}, nil
+	})
 }
 
 func getCognitariumAddr(ctx context.Context, dvClient dvschema.QueryClient) (string, error) {
@@ -111,12 +273,42 @@ var _ TxClient = &txClient{}
 
 type txClient struct {
 	*queryClient
+	executor
+}
 
+// executor sends transactions executing messages on contracts, signed by its signer.
+type executor struct {
 	txClient tx.Client
 	txConfig client.TxConfig
 	signer   keys.Keyring
 }
 
+var _ CognitariumTxClient = &cognitariumTxClient{}
+
+type cognitariumTxClient struct {
+	executor
+
+	contractAddr string
+}
+
+// NewCognitariumTxClient creates a client maintaining the data of the cognitarium contract at the given address,
+// sending the transactions with the given client, signed by the given signer.
+func NewCognitariumTxClient(
+	contractAddr string,
+	client tx.Client,
+	txConfig client.TxConfig,
+	signer keys.Keyring,
+) CognitariumTxClient {
+	return &cognitariumTxClient{
+		executor: executor{
+			txClient: client,
+			txConfig: txConfig,
+			signer:   signer,
+		},
+		contractAddr: contractAddr,
+	}
+}
+
 func NewTxClient(ctx context.Context,
 	grpcAddr, contractAddr string,
 	client tx.Client,
@@ -130,8 +322,10 @@ func NewTxClient(ctx context.Context,
 	}
 	return &txClient{
 		queryClient: qClient.(*queryClient),
-		txClient:    client,
-		txConfig:    txConfig,
-		signer:      signer,
+		executor: executor{
+			txClient: client,
+			txConfig: txConfig,
+			signer:   signer,
+		},
 	}, nil
 }
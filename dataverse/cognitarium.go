@@ -0,0 +1,196 @@
+package dataverse
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+// PatternTerm is a term of a TriplePattern: a Var, an IRI or a Literal.
+type PatternTerm interface {
+	patternTerm()
+}
+
+// Var is a variable of a triple pattern, given by its name without any leading question mark.
+type Var string
+
+// IRI is a full [IRI](https://www.w3.org/TR/rdf11-concepts/#dfn-iri) of a triple pattern.
+type IRI string
+
+// Literal is an RDF [literal](https://www.w3.org/TR/rdf11-concepts/#dfn-literal) of a triple pattern, either simple,
+// language-tagged if Language is set, or typed if Datatype is set.
+type Literal struct {
+	Value    string
+	Language string
+	Datatype IRI
+}
+
+func (Var) patternTerm()     {}
+func (IRI) patternTerm()     {}
+func (Literal) patternTerm() {}
+
+// TriplePattern is a pattern of RDF triples. Its subject and predicate are either a Var or an IRI, its object may also
+// be a Literal.
+type TriplePattern struct {
+	Subject   PatternTerm
+	Predicate PatternTerm
+	Object    PatternTerm
+}
+
+func (t *cognitariumTxClient) InsertData(ctx context.Context, data []byte, format RDFFormat) (*types.TxResponse, error) {
+	if len(data) == 0 {
+		return nil, NewDVError(ErrNoData, nil)
+	}
+
+	dataFormat, err := format.insertFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	return t.executeContract(ctx, t.contractAddr, cgschema.ExecuteMsg{
+		InsertData: &cgschema.ExecuteMsg_InsertData{
+			Data:   cgschema.Binary(base64.StdEncoding.EncodeToString(data)),
+			Format: &dataFormat,
+		},
+	})
+}
+
+func (t *cognitariumTxClient) DeleteData(
+	ctx context.Context,
+	templates []TriplePattern,
+	where []TriplePattern,
+) (*types.TxResponse, error) {
+	msg, err := buildDeleteDataMsg(templates, where)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.executeContract(ctx, t.contractAddr, cgschema.ExecuteMsg{DeleteData: msg})
+}
+
+func buildDeleteDataMsg(templates []TriplePattern, where []TriplePattern) (*cgschema.ExecuteMsg_DeleteData, error) {
+	if len(templates) == 0 && len(where) == 0 {
+		return nil, NewDVError(ErrNoPattern, nil)
+	}
+
+	msg := &cgschema.ExecuteMsg_DeleteData{
+		Delete:   make([]cgschema.TripleDeleteTemplate, 0, len(templates)),
+		Prefixes: []cgschema.Prefix{},
+	}
+	for _, pattern := range templates {
+		template, err := pattern.deleteTemplate()
+		if err != nil {
+			return nil, err
+		}
+		msg.Delete = append(msg.Delete, template)
+	}
+
+	if len(where) > 0 {
+		patterns := make([]cgschema.TriplePattern, 0, len(where))
+		for _, pattern := range where {
+			p, err := pattern.wherePattern()
+			if err != nil {
+				return nil, err
+			}
+			patterns = append(patterns, p)
+		}
+		msg.Where = &cgschema.WhereClause{Bgp: &cgschema.WhereClause_Bgp{Patterns: patterns}}
+	}
+
+	return msg, nil
+}
+
+func (p TriplePattern) deleteTemplate() (cgschema.TripleDeleteTemplate, error) {
+	var template cgschema.TripleDeleteTemplate
+
+	subject, err := varOrNamedNode(p.Subject, "subject")
+	if err != nil {
+		return template, err
+	}
+	predicate, err := varOrNamedNode(p.Predicate, "predicate")
+	if err != nil {
+		return template, err
+	}
+	template.Subject, template.Predicate = subject, predicate
+
+	switch o := p.Object.(type) {
+	case Var:
+		template.Object.Variable = ref(cgschema.VarOrNamedNodeOrLiteral_Variable(o))
+	case IRI:
+		template.Object.NamedNode = &cgschema.VarOrNamedNodeOrLiteral_NamedNode{Full: ref(cgschema.IRI_Full(o))}
+	case Literal:
+		template.Object.Literal = ref(cgschema.VarOrNamedNodeOrLiteral_Literal(o.literal()))
+	default:
+		return template, invalidTerm("object", p.Object)
+	}
+
+	return template, nil
+}
+
+func (p TriplePattern) wherePattern() (cgschema.TriplePattern, error) {
+	var pattern cgschema.TriplePattern
+
+	switch s := p.Subject.(type) {
+	case Var:
+		pattern.Subject.Variable = ref(cgschema.VarOrNode_Variable(s))
+	case IRI:
+		pattern.Subject.Node = &cgschema.VarOrNode_Node{NamedNode: &cgschema.Node_NamedNode{Full: ref(cgschema.IRI_Full(s))}}
+	default:
+		return pattern, invalidTerm("subject", p.Subject)
+	}
+
+	predicate, err := varOrNamedNode(p.Predicate, "predicate")
+	if err != nil {
+		return pattern, err
+	}
+	pattern.Predicate = predicate
+
+	switch o := p.Object.(type) {
+	case Var:
+		pattern.Object.Variable = ref(cgschema.VarOrNodeOrLiteral_Variable(o))
+	case IRI:
+		pattern.Object.Node = &cgschema.VarOrNodeOrLiteral_Node{NamedNode: &cgschema.Node_NamedNode{Full: ref(cgschema.IRI_Full(o))}}
+	case Literal:
+		pattern.Object.Literal = ref(cgschema.VarOrNodeOrLiteral_Literal(o.literal()))
+	default:
+		return pattern, invalidTerm("object", p.Object)
+	}
+
+	return pattern, nil
+}
+
+func varOrNamedNode(term PatternTerm, position string) (cgschema.VarOrNamedNode, error) {
+	switch v := term.(type) {
+	case Var:
+		return cgschema.VarOrNamedNode{Variable: ref(cgschema.VarOrNamedNode_Variable(v))}, nil
+	case IRI:
+		return cgschema.VarOrNamedNode{NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: ref(cgschema.IRI_Full(v))}}, nil
+	default:
+		return cgschema.VarOrNamedNode{}, invalidTerm(position, term)
+	}
+}
+
+func (l Literal) literal() cgschema.Literal {
+	switch {
+	case l.Language != "":
+		return cgschema.Literal{
+			LanguageTaggedString: &cgschema.Literal_LanguageTaggedString{Language: l.Language, Value: l.Value},
+		}
+	case l.Datatype != "":
+		return cgschema.Literal{
+			TypedValue: &cgschema.Literal_TypedValue{
+				Datatype: cgschema.IRI{Full: ref(cgschema.IRI_Full(l.Datatype))},
+				Value:    l.Value,
+			},
+		}
+	default:
+		return cgschema.Literal{Simple: ref(cgschema.Literal_Simple(l.Value))}
+	}
+}
+
+func invalidTerm(position string, term PatternTerm) error {
+	return NewDVError(ErrInvalidPattern, fmt.Errorf("unexpected %s %#v", position, term))
+}
@@ -0,0 +1,217 @@
+package dataverse_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	"github.com/axone-protocol/axone-sdk/tx"
+	"github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	ontologyClass = "https://example.org/ontology/Dataset"
+	rdfsLabel     = "http://www.w3.org/2000/01/rdf-schema#label"
+)
+
+func newCognitariumTxClient(controller *gomock.Controller, sendTx bool, sendTxError error) dataverse.CognitariumTxClient {
+	txConfig, err := tx.MakeDefaultTxConfig()
+	So(err, ShouldBeNil)
+
+	mockTxClient := testutil.NewMockTxClient(controller)
+	mockKeyring := testutil.NewMockKeyring(controller)
+
+	mockKeyring.EXPECT().Addr().Return("addr").AnyTimes()
+	if sendTx {
+		mockTxClient.EXPECT().
+			SendTx(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, transaction tx.Transaction) (*types.TxResponse, error) {
+				So(transaction.Sender(), ShouldEqual, "addr")
+				if sendTxError != nil {
+					return nil, sendTxError
+				}
+				return &types.TxResponse{}, nil
+			}).
+			Times(1)
+	}
+
+	return dataverse.NewCognitariumTxClient("axone1cognitarium", mockTxClient, txConfig, mockKeyring)
+}
+
+func TestCognitariumClient_InsertData(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        []byte
+		format      dataverse.RDFFormat
+		sendTxError error
+		wantErr     error
+	}{
+		{
+			name:   "insert n-triples",
+			data:   []byte(graphNTriples),
+			format: dataverse.RDFFormatNTriples,
+		},
+		{
+			name:   "insert turtle",
+			data:   []byte(`<https://example.org/ontology/Dataset> a <http://www.w3.org/2000/01/rdf-schema#Class> .`),
+			format: dataverse.RDFFormatTurtle,
+		},
+		{
+			name:   "insert rdf/xml",
+			data:   []byte(`<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"/>`),
+			format: dataverse.RDFFormatRDFXML,
+		},
+		{
+			name:    "unsupported json-ld",
+			data:    []byte(`[]`),
+			format:  dataverse.RDFFormatJSONLD,
+			wantErr: dataverse.NewDVError(dataverse.ErrUnsupportedFormat, fmt.Errorf("json_ld")),
+		},
+		{
+			name:    "no data",
+			format:  dataverse.RDFFormatTurtle,
+			wantErr: dataverse.NewDVError(dataverse.ErrNoData, nil),
+		},
+		{
+			name:        "transaction error",
+			data:        []byte(graphNTriples),
+			format:      dataverse.RDFFormatNTriples,
+			sendTxError: fmt.Errorf("unauthorized"),
+			wantErr:     dataverse.NewDVError(dataverse.ErrSendTx, fmt.Errorf("unauthorized")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a cognitarium client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				sendTx := test.wantErr == nil || test.sendTxError != nil
+				client := newCognitariumTxClient(controller, sendTx, test.sendTxError)
+
+				Convey("When InsertData is called", func() {
+					r, err := client.InsertData(context.Background(), test.data, test.format)
+
+					Convey("Then should return expected error", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(r, ShouldNotBeNil)
+						} else {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(r, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestCognitariumClient_DeleteData(t *testing.T) {
+	tests := []struct {
+		name        string
+		templates   []dataverse.TriplePattern
+		where       []dataverse.TriplePattern
+		sendTxError error
+		wantErr     error
+	}{
+		{
+			name: "delete matching triples",
+			where: []dataverse.TriplePattern{
+				{Subject: dataverse.IRI(ontologyClass), Predicate: dataverse.IRI(rdfsLabel), Object: dataverse.Var("label")},
+			},
+		},
+		{
+			name:    "no pattern",
+			wantErr: dataverse.NewDVError(dataverse.ErrNoPattern, nil),
+		},
+		{
+			name: "invalid pattern",
+			templates: []dataverse.TriplePattern{
+				{Subject: dataverse.Literal{Value: "foo"}, Predicate: dataverse.IRI(rdfsLabel), Object: dataverse.Var("label")},
+			},
+			wantErr: dataverse.NewDVError(dataverse.ErrInvalidPattern,
+				fmt.Errorf(`unexpected subject dataverse.Literal{Value:"foo", Language:"", Datatype:""}`)),
+		},
+		{
+			name: "transaction error",
+			templates: []dataverse.TriplePattern{
+				{Subject: dataverse.IRI(ontologyClass), Predicate: dataverse.IRI(rdfsLabel), Object: dataverse.Literal{Value: "Dataset"}},
+			},
+			sendTxError: fmt.Errorf("unauthorized"),
+			wantErr:     dataverse.NewDVError(dataverse.ErrSendTx, fmt.Errorf("unauthorized")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a cognitarium client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				sendTx := test.wantErr == nil || test.sendTxError != nil
+				client := newCognitariumTxClient(controller, sendTx, test.sendTxError)
+
+				Convey("When DeleteData is called", func() {
+					r, err := client.DeleteData(context.Background(), test.templates, test.where)
+
+					Convey("Then should return expected error", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(r, ShouldNotBeNil)
+						} else {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(r, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestBuildDeleteDataMsg(t *testing.T) {
+	Convey("Given deletion templates and where patterns", t, func() {
+		templates := []dataverse.TriplePattern{
+			{Subject: dataverse.Var("s"), Predicate: dataverse.IRI(rdfsLabel), Object: dataverse.Literal{Value: "Dataset", Language: "en"}},
+			{
+				Subject:   dataverse.Var("s"),
+				Predicate: dataverse.Var("p"),
+				Object: dataverse.Literal{
+					Value:    "1",
+					Datatype: dataverse.IRI("http://www.w3.org/2001/XMLSchema#integer"),
+				},
+			},
+		}
+		where := []dataverse.TriplePattern{
+			{Subject: dataverse.Var("s"), Predicate: dataverse.Var("p"), Object: dataverse.IRI(ontologyClass)},
+		}
+
+		Convey("When the delete message is built", func() {
+			msg, err := dataverse.BuildDeleteDataMsg(templates, where)
+			So(err, ShouldBeNil)
+
+			raw, err := json.Marshal(msg)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be the expected cognitarium message", func() {
+				So(string(raw), ShouldEqual, `{"delete":[`+
+					`{"object":{"literal":{"language_tagged_string":{"language":"en","value":"Dataset"}}},`+
+					`"predicate":{"named_node":{"full":"http://www.w3.org/2000/01/rdf-schema#label"}},"subject":{"variable":"s"}},`+
+					`{"object":{"literal":{"typed_value":{"datatype":{"full":"http://www.w3.org/2001/XMLSchema#integer"},"value":"1"}}},`+
+					`"predicate":{"variable":"p"},"subject":{"variable":"s"}}],`+
+					`"prefixes":[],`+
+					`"where":{"bgp":{"patterns":[{"object":{"node":{"named_node":{"full":"https://example.org/ontology/Dataset"}}},`+
+					`"predicate":{"variable":"p"},"subject":{"variable":"s"}}]}}}`)
+			})
+		})
+	})
+}
@@ -0,0 +1,178 @@
+package dataverse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+	dvschema "github.com/axone-protocol/axone-contract-schema/go/dataverse-schema/v6"
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"google.golang.org/grpc"
+)
+
+// smartQuerier performs the smart queries of contracts, whatever the transport used to reach the node.
+type smartQuerier interface {
+	// smartQuery sends the given query message to the contract at the given address and decodes its response into
+	// the given value.
+	smartQuery(ctx context.Context, contractAddr string, msg any, response any, opts ...grpc.CallOption) error
+}
+
+// newContractQueryClient creates a QueryClient of the dataverse contract at the given address, resolving the address
+// of its cognitarium contract, all the contracts being queried with the given querier.
+func newContractQueryClient(
+	ctx context.Context,
+	querier smartQuerier,
+	contractAddr string,
+	lawStoneFactory LawStoneFactory,
+) (QueryClient, error) {
+	dataverseClient := &contractDataverseClient{querier, contractAddr}
+	cognitariumAddr, err := getCognitariumAddr(ctx, dataverseClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cognitarium address: %w", err)
+	}
+
+	return &queryClient{
+		contractAddr,
+		cognitariumAddr,
+
+		dataverseClient,
+		&contractCognitariumClient{querier, cognitariumAddr},
+		lawStoneFactory,
+		nil,
+	}, nil
+}
+
+var _ smartQuerier = &grpcQuerier{}
+
+// grpcQuerier performs the smart queries of contracts through a gRPC connection to a node, shared by all the
+// contracts queried.
+type grpcQuerier struct {
+	conn grpc.ClientConnInterface
+}
+
+func (q *grpcQuerier) smartQuery(
+	ctx context.Context,
+	contractAddr string,
+	msg any,
+	response any,
+	opts ...grpc.CallOption,
+) error {
+	queryData, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	in := &wasmtypes.QuerySmartContractStateRequest{
+		Address:   contractAddr,
+		QueryData: queryData,
+	}
+	out := new(wasmtypes.QuerySmartContractStateResponse)
+	if err := q.conn.Invoke(ctx, "/cosmwasm.wasm.v1.Query/SmartContractState", in, out, opts...); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(out.Data, response)
+}
+
+// contractQuery sends the query message of the given name to the contract at the given address, as the generated
+// clients of the contracts do.
+func contractQuery[T any](
+	ctx context.Context,
+	querier smartQuerier,
+	contractAddr, name string,
+	req any,
+	opts ...grpc.CallOption,
+) (*T, error) {
+	var response T
+	if err := querier.smartQuery(ctx, contractAddr, map[string]any{name: req}, &response, opts...); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+var _ dvschema.QueryClient = &contractDataverseClient{}
+
+type contractDataverseClient struct {
+	querier smartQuerier
+	address string
+}
+
+func (c *contractDataverseClient) Dataverse(
+	ctx context.Context,
+	req *dvschema.QueryMsg_Dataverse,
+	opts ...grpc.CallOption,
+) (*dvschema.DataverseResponse, error) {
+	return contractQuery[dvschema.DataverseResponse](ctx, c.querier, c.address, "dataverse", req, opts...)
+}
+
+var _ cgschema.QueryClient = &contractCognitariumClient{}
+
+type contractCognitariumClient struct {
+	querier smartQuerier
+	address string
+}
+
+func (c *contractCognitariumClient) Construct(
+	ctx context.Context,
+	req *cgschema.QueryMsg_Construct,
+	opts ...grpc.CallOption,
+) (*cgschema.ConstructResponse, error) {
+	return contractQuery[cgschema.ConstructResponse](ctx, c.querier, c.address, "construct", req, opts...)
+}
+
+func (c *contractCognitariumClient) Describe(
+	ctx context.Context,
+	req *cgschema.QueryMsg_Describe,
+	opts ...grpc.CallOption,
+) (*cgschema.DescribeResponse, error) {
+	return contractQuery[cgschema.DescribeResponse](ctx, c.querier, c.address, "describe", req, opts...)
+}
+
+func (c *contractCognitariumClient) Select(
+	ctx context.Context,
+	req *cgschema.QueryMsg_Select,
+	opts ...grpc.CallOption,
+) (*cgschema.SelectResponse, error) {
+	return contractQuery[cgschema.SelectResponse](ctx, c.querier, c.address, "select", req, opts...)
+}
+
+func (c *contractCognitariumClient) Store(
+	ctx context.Context,
+	req *cgschema.QueryMsg_Store,
+	opts ...grpc.CallOption,
+) (*cgschema.StoreResponse, error) {
+	return contractQuery[cgschema.StoreResponse](ctx, c.querier, c.address, "store", req, opts...)
+}
+
+var _ lsschema.QueryClient = &contractLawStoneClient{}
+
+type contractLawStoneClient struct {
+	querier smartQuerier
+	address string
+}
+
+func (c *contractLawStoneClient) Ask(
+	ctx context.Context,
+	req *lsschema.QueryMsg_Ask,
+	opts ...grpc.CallOption,
+) (*lsschema.AskResponse, error) {
+	return contractQuery[lsschema.AskResponse](ctx, c.querier, c.address, "ask", req, opts...)
+}
+
+func (c *contractLawStoneClient) Program(
+	ctx context.Context,
+	req *lsschema.QueryMsg_Program,
+	opts ...grpc.CallOption,
+) (*lsschema.ProgramResponse, error) {
+	return contractQuery[lsschema.ProgramResponse](ctx, c.querier, c.address, "program", req, opts...)
+}
+
+func (c *contractLawStoneClient) ProgramCode(
+	ctx context.Context,
+	req *lsschema.QueryMsg_ProgramCode,
+	opts ...grpc.CallOption,
+) (*string, error) {
+	return contractQuery[string](ctx, c.querier, c.address, "program_code", req, opts...)
+}
@@ -0,0 +1,93 @@
+package dataverse_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	dvschema "github.com/axone-protocol/axone-contract-schema/go/dataverse-schema/v6"
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// wasmResponses are the responses of the contracts of a dataverse governed by the axone1gov and axone1other
+// governances.
+func wasmResponses() map[string]any {
+	permitted := lsschema.AskResponse{
+		Answer: &lsschema.Answer{
+			Variables: []string{"Result", "Evidence"},
+			Results: []lsschema.Result{{Substitutions: []lsschema.Substitution{
+				{Variable: "Result", Expression: "permitted"},
+				{Variable: "Evidence", Expression: "[]"},
+			}}},
+		},
+	}
+	return map[string]any{
+		restDataverseAddr + "/dataverse": dvschema.DataverseResponse{
+			Name:               "my-dataverse",
+			TriplestoreAddress: restCognitariumAddr,
+		},
+		// Select responses are given raw, values not being marshalable.
+		restCognitariumAddr + "/select": json.RawMessage(`{
+			"head": {"vars": ["code"]},
+			"results": {"bindings": [{"code": {"type": "uri", "value": {"full": "contract:law-stone:axone1gov"}}}]}
+		}`),
+		"axone1gov/ask":            permitted,
+		"axone1gov/program_code":   base64.StdEncoding.EncodeToString([]byte("tell(_, read, permitted, []).")),
+		"axone1other/ask":          permitted,
+		"axone1other/program_code": base64.StdEncoding.EncodeToString([]byte("tell(_, _, permitted, []).")),
+	}
+}
+
+func TestNewQueryClient_SharedConn(t *testing.T) {
+	Convey("Given the gRPC endpoint of a node", t, func() {
+		server, err := testutil.NewWasmServer(wasmResponses())
+		So(err, ShouldBeNil)
+		Reset(server.Close)
+
+		client, err := dataverse.NewQueryClient(context.Background(), server.Addr, restDataverseAddr)
+		So(err, ShouldBeNil)
+
+		Convey("When several governances are queried repeatedly", func() {
+			var errs []error
+			addr, err := client.GetResourceGovAddr(context.Background(), "did:key:resource")
+			errs = append(errs, err)
+			for range 5 {
+				for _, gov := range []string{addr, "axone1other"} {
+					permitted, err := client.AskGovTellAction(context.Background(), gov, "did:key:user", "read")
+					errs = append(errs, err)
+					So(permitted, ShouldBeTrue)
+					actions, err := client.AskGovPermittedActions(context.Background(), gov, "did:key:user")
+					errs = append(errs, err)
+					So(actions, ShouldBeEmpty)
+					_, err = client.GovCode(context.Background(), gov)
+					errs = append(errs, err)
+				}
+			}
+			code, errCode := client.GovCode(context.Background(), "axone1other")
+
+			Convey("Then all the contracts should be queried through a single connection", func() {
+				for _, err := range errs {
+					So(err, ShouldBeNil)
+				}
+				So(addr, ShouldEqual, "axone1gov")
+				So(errCode, ShouldBeNil)
+				So(code, ShouldEqual, "tell(_, _, permitted, []).")
+				So(server.Queries(), ShouldEqual, 33)
+				So(server.Conns(), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When an unknown governance is queried", func() {
+			_, err := client.GovCode(context.Background(), "axone1unknown")
+
+			Convey("Then the error of the node should be returned", func() {
+				So(err.Error(), ShouldEqual,
+					"failed to query law-stone contract: rpc error: code = NotFound desc = no such contract: address axone1unknown")
+			})
+		})
+	})
+}
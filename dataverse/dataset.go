@@ -0,0 +1,175 @@
+package dataverse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+)
+
+// DefaultPageLimit is the number of elements returned in a page when no limit is specified.
+const DefaultPageLimit = 10
+
+// DatasetFilter holds the criteria a dataset description must match to be returned by a search, empty criteria are
+// ignored.
+type DatasetFilter struct {
+	// Tags the dataset must all be tagged with.
+	Tags []string
+	// Topic IRI the dataset must be about.
+	Topic string
+	// Format IRI the dataset must be in.
+	Format string
+	// Title is a case-insensitive substring the dataset title must contain.
+	Title string
+}
+
+// Pagination describes the page of results to return.
+type Pagination struct {
+	// Offset is the number of results to skip.
+	Offset int
+	// Limit is the maximum number of results to return, DefaultPageLimit is used if not strictly positive.
+	Limit int
+}
+
+// DatasetPage is a page of datasets resulting from a search.
+type DatasetPage struct {
+	// Datasets of the page.
+	Datasets []DatasetSummary
+	// HasMore tells if more datasets are available after this page.
+	HasMore bool
+	// Truncated tells if the search reached the maximum query limit of the cognitarium, in which case matching
+	// datasets beyond this limit are neither returned nor accounted for by HasMore.
+	Truncated bool
+}
+
+// DatasetSummary holds the description of a dataset as claimed by a DatasetDescriptionCredential.
+type DatasetSummary struct {
+	// DID of the dataset.
+	DID string
+	// CredentialID is the identifier of the credential carrying the description.
+	CredentialID string
+	// Title of the dataset.
+	Title string
+	// Description of the dataset.
+	Description string
+	// Format IRI of the dataset.
+	Format string
+	// Topic IRI of the dataset.
+	Topic string
+	// Tags of the dataset.
+	Tags []string
+}
+
+func (c *queryClient) SearchDatasets(ctx context.Context, filter DatasetFilter, page Pagination) (*DatasetPage, error) {
+	if page.Limit <= 0 {
+		page.Limit = DefaultPageLimit
+	}
+
+	maxLimit, err := c.maxQueryLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The title is matched locally as the cognitarium has no support for substring filtering, in this case all the
+	// results the cognitarium allows are retrieved.
+	limit := maxLimit
+	if filter.Title == "" {
+		limit = min(page.Offset+page.Limit+1, maxLimit)
+	}
+
+	response, err := c.cognitariumClient.Select(ctx, &cgschema.QueryMsg_Select{
+		Query: buildSearchDatasetsRequest(filter, ref(limit)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	datasets := make([]DatasetSummary, 0, len(response.Results.Bindings))
+	for _, binding := range response.Results.Bindings {
+		dataset, err := datasetFromBinding(binding)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.Contains(strings.ToLower(dataset.Title), strings.ToLower(filter.Title)) {
+			continue
+		}
+		datasets = append(datasets, dataset)
+	}
+
+	result := &DatasetPage{
+		Datasets:  []DatasetSummary{},
+		HasMore:   len(datasets) > page.Offset+page.Limit,
+		Truncated: limit == maxLimit && len(response.Results.Bindings) >= maxLimit,
+	}
+	if page.Offset >= len(datasets) {
+		return result, nil
+	}
+	result.Datasets = datasets[page.Offset:min(page.Offset+page.Limit, len(datasets))]
+
+	if err := c.fillDatasetsDetails(ctx, result.Datasets, maxLimit); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func datasetFromBinding(binding map[string]cgschema.Value) (DatasetSummary, error) {
+	credID, err := bindingIRI(binding, "credId")
+	if err != nil {
+		return DatasetSummary{}, err
+	}
+	did, err := bindingIRI(binding, "dataset")
+	if err != nil {
+		return DatasetSummary{}, err
+	}
+	title, err := bindingLiteral(binding, "title")
+	if err != nil {
+		return DatasetSummary{}, err
+	}
+
+	return DatasetSummary{
+		DID:          did,
+		CredentialID: credID,
+		Title:        title,
+		Tags:         []string{},
+	}, nil
+}
+
+// fillDatasetsDetails retrieves the properties claimed by the dataset description credentials not returned by the
+// search query, as they may have multiple or no value.
+func (c *queryClient) fillDatasetsDetails(ctx context.Context, datasets []DatasetSummary, maxLimit int) error {
+	credIDs := make([]string, 0, len(datasets))
+	for _, dataset := range datasets {
+		credIDs = append(credIDs, dataset.CredentialID)
+	}
+
+	claims, err := c.credentialsClaims(ctx, credIDs, maxLimit)
+	if err != nil {
+		return err
+	}
+
+	namespace := fmt.Sprintf("%s/schema/credential/dataset/description/", W3IDPrefix)
+	for i := range datasets {
+		dataset := &datasets[i]
+		for _, claim := range claims[dataset.CredentialID] {
+			property, ok := strings.CutPrefix(claim.predicate, namespace)
+			if !ok {
+				continue
+			}
+
+			switch property {
+			case "hasDescription":
+				dataset.Description = claim.value
+			case "hasFormat":
+				dataset.Format = claim.value
+			case "hasTopic":
+				dataset.Topic = claim.value
+			case "hasTag":
+				dataset.Tags = append(dataset.Tags, claim.value)
+			}
+		}
+	}
+
+	return nil
+}
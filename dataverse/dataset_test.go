@@ -0,0 +1,302 @@
+package dataverse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+const datasetNS = "https://w3id.org/axone/ontology/v4/schema/credential/dataset/description/"
+
+func uriValue(iri string) cgschema.Value {
+	return cgschema.Value{ValueType: cgschema.URI{Type: "uri", Value: cgschema.IRI{Full: toAddress(cgschema.IRI_Full(iri))}}}
+}
+
+func literalValue(value string) cgschema.Value {
+	return cgschema.Value{ValueType: cgschema.Value_Literal{Type: "literal", Value: value}}
+}
+
+func datasetBinding(n int, title string) map[string]cgschema.Value {
+	return map[string]cgschema.Value{
+		"credId":  uriValue(fmt.Sprintf("%s%d", datasetNS, n)),
+		"dataset": uriValue(fmt.Sprintf("did:key:dataset%d", n)),
+		"title":   literalValue(title),
+	}
+}
+
+func datasetBindings(from, count int, title string) []map[string]cgschema.Value {
+	bindings := make([]map[string]cgschema.Value, 0, count)
+	for n := from; n < from+count; n++ {
+		bindings = append(bindings, datasetBinding(n, title))
+	}
+	return bindings
+}
+
+func datasetDetails(credID string) []map[string]cgschema.Value {
+	details := []map[string]cgschema.Value{
+		{"p": uriValue(datasetNS + "hasTitle"), "o": literalValue("ignored")},
+		{"p": uriValue(datasetNS + "hasDescription"), "o": literalValue("description")},
+		{"p": uriValue(datasetNS + "hasFormat"), "o": uriValue("format")},
+		{"p": uriValue(datasetNS + "hasTopic"), "o": uriValue("topic")},
+		{"p": uriValue(datasetNS + "hasTag"), "o": literalValue("tag1")},
+		{"p": uriValue(datasetNS + "hasTag"), "o": literalValue("tag2")},
+		{"p": uriValue("https://example.org/other"), "o": cgschema.Value{ValueType: cgschema.BlankNode{}}},
+	}
+	for _, binding := range details {
+		binding["credId"] = uriValue(credID)
+	}
+	return details
+}
+
+// selectDatasetsDetails answers the select of the claims of the credentials filtered by the query, up to its limit.
+func selectDatasetsDetails(query cgschema.SelectQuery) *cgschema.SelectResponse {
+	bindings := []map[string]cgschema.Value{}
+	for _, alternative := range *query.Where.Filter.Expr.Or {
+		bindings = append(bindings, datasetDetails(string(*alternative.Equal.F1.NamedNode.Full))...)
+	}
+	return &cgschema.SelectResponse{
+		Head:    cgschema.Head{Vars: []string{"credId", "p", "o"}},
+		Results: cgschema.Results{Bindings: bindings[:min(len(bindings), *query.Limit)]},
+	}
+}
+
+func datasetSummary(n int, title string) dataverse.DatasetSummary {
+	return dataverse.DatasetSummary{
+		DID:          fmt.Sprintf("did:key:dataset%d", n),
+		CredentialID: fmt.Sprintf("%s%d", datasetNS, n),
+		Title:        title,
+		Description:  "description",
+		Format:       "format",
+		Topic:        "topic",
+		Tags:         []string{"tag1", "tag2"},
+	}
+}
+
+func TestClient_SearchDatasets(t *testing.T) {
+	tests := []struct {
+		name          string
+		filter        dataverse.DatasetFilter
+		page          dataverse.Pagination
+		maxLimit      int
+		wantLimit     *int
+		wantPatterns  int
+		bindings      []map[string]cgschema.Value
+		responseError error
+		wantErr       error
+		wantSelects   int
+		wantResult    *dataverse.DatasetPage
+	}{
+		{
+			name:         "search without filter",
+			wantLimit:    toAddress(11),
+			wantPatterns: 4,
+			bindings:     []map[string]cgschema.Value{datasetBinding(1, "Weather"), datasetBinding(2, "Traffic")},
+			wantSelects:  2,
+			wantResult: &dataverse.DatasetPage{
+				Datasets: []dataverse.DatasetSummary{datasetSummary(1, "Weather"), datasetSummary(2, "Traffic")},
+				HasMore:  false,
+			},
+		},
+		{
+			name: "search with tags, topic and format",
+			filter: dataverse.DatasetFilter{
+				Tags:   []string{"tag1", "tag2"},
+				Topic:  "topic",
+				Format: "format",
+			},
+			page:         dataverse.Pagination{Limit: 5},
+			wantLimit:    toAddress(6),
+			wantPatterns: 8,
+			bindings:     []map[string]cgschema.Value{datasetBinding(1, "Weather")},
+			wantSelects:  2,
+			wantResult: &dataverse.DatasetPage{
+				Datasets: []dataverse.DatasetSummary{datasetSummary(1, "Weather")},
+				HasMore:  false,
+			},
+		},
+		{
+			name:         "search by title",
+			filter:       dataverse.DatasetFilter{Title: "weath"},
+			wantLimit:    toAddress(30),
+			wantPatterns: 4,
+			bindings: []map[string]cgschema.Value{
+				datasetBinding(1, "Weather"),
+				datasetBinding(2, "Traffic"),
+				datasetBinding(3, "Weather forecast"),
+			},
+			wantSelects: 2,
+			wantResult: &dataverse.DatasetPage{
+				Datasets: []dataverse.DatasetSummary{datasetSummary(1, "Weather"), datasetSummary(3, "Weather forecast")},
+				HasMore:  false,
+			},
+		},
+		{
+			name:         "search by title reaching the maximum query limit",
+			filter:       dataverse.DatasetFilter{Title: "weath"},
+			page:         dataverse.Pagination{Limit: 2},
+			maxLimit:     8,
+			wantLimit:    toAddress(8),
+			wantPatterns: 4,
+			bindings: append(
+				[]map[string]cgschema.Value{datasetBinding(1, "Weather"), datasetBinding(2, "Traffic")},
+				datasetBindings(3, 6, "Weather forecast")...,
+			),
+			wantSelects: 4,
+			wantResult: &dataverse.DatasetPage{
+				Datasets:  []dataverse.DatasetSummary{datasetSummary(1, "Weather"), datasetSummary(3, "Weather forecast")},
+				HasMore:   true,
+				Truncated: true,
+			},
+		},
+		{
+			name:         "search a page beyond the maximum query limit",
+			page:         dataverse.Pagination{Offset: 6, Limit: 5},
+			maxLimit:     8,
+			wantLimit:    toAddress(8),
+			wantPatterns: 4,
+			bindings:     datasetBindings(1, 8, "Weather"),
+			wantSelects:  4,
+			wantResult: &dataverse.DatasetPage{
+				Datasets:  []dataverse.DatasetSummary{datasetSummary(7, "Weather"), datasetSummary(8, "Weather")},
+				HasMore:   false,
+				Truncated: true,
+			},
+		},
+		{
+			name:         "search with details exceeding the maximum query limit",
+			maxLimit:     10,
+			wantLimit:    toAddress(10),
+			wantPatterns: 4,
+			bindings:     []map[string]cgschema.Value{datasetBinding(1, "Weather"), datasetBinding(2, "Traffic")},
+			wantSelects:  4,
+			wantResult: &dataverse.DatasetPage{
+				Datasets: []dataverse.DatasetSummary{datasetSummary(1, "Weather"), datasetSummary(2, "Traffic")},
+				HasMore:  false,
+			},
+		},
+		{
+			name:         "search with details of a dataset exceeding the maximum query limit",
+			maxLimit:     5,
+			wantLimit:    toAddress(5),
+			wantPatterns: 4,
+			bindings:     []map[string]cgschema.Value{datasetBinding(1, "Weather")},
+			wantErr: dataverse.NewDVError(
+				dataverse.ErrQueryLimit,
+				fmt.Errorf("more than 5 properties claimed by %s1", datasetNS),
+			),
+			wantSelects: 2,
+		},
+		{
+			name:         "search a page in the middle",
+			page:         dataverse.Pagination{Offset: 1, Limit: 1},
+			wantLimit:    toAddress(3),
+			wantPatterns: 4,
+			bindings: []map[string]cgschema.Value{
+				datasetBinding(1, "Weather"),
+				datasetBinding(2, "Traffic"),
+				datasetBinding(3, "Weather forecast"),
+			},
+			wantSelects: 2,
+			wantResult: &dataverse.DatasetPage{
+				Datasets: []dataverse.DatasetSummary{datasetSummary(2, "Traffic")},
+				HasMore:  true,
+			},
+		},
+		{
+			name:         "search a page after the last result",
+			page:         dataverse.Pagination{Offset: 2, Limit: 1},
+			wantLimit:    toAddress(4),
+			wantPatterns: 4,
+			bindings:     []map[string]cgschema.Value{datasetBinding(1, "Weather")},
+			wantSelects:  1,
+			wantResult: &dataverse.DatasetPage{
+				Datasets: []dataverse.DatasetSummary{},
+				HasMore:  false,
+			},
+		},
+		{
+			name:          "grpc error",
+			wantLimit:     toAddress(11),
+			wantPatterns:  4,
+			responseError: fmt.Errorf("gRPC: connection refused"),
+			wantErr:       fmt.Errorf("gRPC: connection refused"),
+			wantSelects:   1,
+		},
+		{
+			name:         "invalid binding in response",
+			wantLimit:    toAddress(11),
+			wantPatterns: 4,
+			bindings:     []map[string]cgschema.Value{{"credId": uriValue("foo")}},
+			wantErr:      dataverse.NewDVError(dataverse.ErrVarNotFound, nil),
+			wantSelects:  1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a mocked cognitarium client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				maxLimit := 30
+				if test.maxLimit != 0 {
+					maxLimit = test.maxLimit
+				}
+				selects := 0
+				mockCognitarium := testutil.NewMockCognitariumQueryClient(controller)
+				mockCognitarium.
+					EXPECT().
+					Store(gomock.Any(), gomock.Any()).
+					Return(&cgschema.StoreResponse{Limits: cgschema.StoreLimits{MaxQueryLimit: maxLimit}}, nil).
+					Times(1)
+				mockCognitarium.
+					EXPECT().
+					Select(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *cgschema.QueryMsg_Select, _ ...any) (*cgschema.SelectResponse, error) {
+						selects++
+						if req.Query.Where.Filter != nil {
+							return selectDatasetsDetails(req.Query), nil
+						}
+
+						So(req.Query.Limit, ShouldResemble, test.wantLimit)
+						So(req.Query.Where.Bgp.Patterns, ShouldHaveLength, test.wantPatterns)
+						if test.responseError != nil {
+							return nil, test.responseError
+						}
+						return &cgschema.SelectResponse{
+							Head:    cgschema.Head{Vars: []string{"credId", "dataset", "title"}},
+							Results: cgschema.Results{Bindings: test.bindings},
+						}, nil
+					}).
+					AnyTimes()
+
+				client := dataverse.NewDataverseQueryClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					mockCognitarium,
+					nil,
+				)
+
+				Convey("When SearchDatasets is called", func() {
+					result, err := client.SearchDatasets(context.Background(), test.filter, test.page)
+
+					Convey("Then the expected page of datasets should be returned", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(result, ShouldResemble, test.wantResult)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(result, ShouldBeNil)
+						}
+						So(selects, ShouldEqual, test.wantSelects)
+					})
+				})
+			})
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package dataverse
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/axone-protocol/axone-sdk/prolog"
+)
+
+// PermittedResult is the result of tell/4 when the governance permits an action.
+const PermittedResult = prolog.Atom("permitted")
+
+// Decision is the outcome of a governance asked whether an action is permitted, as given by the tell/4 predicate.
+type Decision struct {
+	// GovAddr is the address of the governance (law-stone contract) queried.
+	GovAddr string
+	// DID of the identity asking to perform the action.
+	DID string
+	// Action asked to be performed.
+	Action string
+	// Result is the raw result term given by the governance, e.g. permitted or prohibited. It is nil if the
+	// governance gave no result.
+	Result prolog.Term
+	// Evidence is the term given by the governance to justify its result, nil if none.
+	Evidence prolog.Term
+	// Error raised by the governance while deciding, if any.
+	Error string
+}
+
+// Permitted tells if the governance permits the action.
+func (d *Decision) Permitted() bool {
+	return d.Result == PermittedResult
+}
+
+func (d *Decision) String() string {
+	result := "no result"
+	if d.Result != nil {
+		result = d.Result.String()
+	}
+	s := fmt.Sprintf("governance %s decided %s for %s to %s", d.GovAddr, result, d.DID, d.Action)
+	if d.Evidence != nil {
+		s += fmt.Sprintf(" with evidence %s", d.Evidence)
+	}
+	if d.Error != "" {
+		s += fmt.Sprintf(" (error: %s)", d.Error)
+	}
+	return s
+}
+
+func (c *queryClient) AskGovDecision(ctx context.Context, addr, did, action string) (*Decision, error) {
+	query, err := prolog.NewQuery().
+		Goal("tell", prolog.Atom(did), prolog.Atom(action), prolog.Variable("Result"), prolog.Variable("Evidence")).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build governance query: %w", err)
+	}
+
+	answer, err := c.AskGov(ctx, addr, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// As for AskGovTellAction, an answer made of several results is ambiguous and gives no decision.
+	decision := &Decision{GovAddr: addr, DID: did, Action: action}
+	if len(answer.Results) != 1 {
+		return decision, nil
+	}
+
+	result := answer.Results[0]
+	decision.Error = result.Error
+	decision.Result, _ = result.Get("Result")
+	if evidence, ok := result.Get("Evidence"); ok {
+		if _, unbound := evidence.(prolog.Variable); !unbound {
+			decision.Evidence = evidence
+		}
+	}
+
+	return decision, nil
+}
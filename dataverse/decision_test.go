@@ -0,0 +1,221 @@
+package dataverse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/prolog"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestClient_AskGovDecision(t *testing.T) {
+	const did = "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5"
+
+	tests := []struct {
+		name          string
+		addr          string
+		response      *lsschema.AskResponse
+		responseError error
+		wantErr       error
+		wantResult    *dataverse.Decision
+		wantPermitted bool
+	}{
+		{
+			name:    "law stone client new error",
+			addr:    "error",
+			wantErr: fmt.Errorf("failed to create law-stone client: error"),
+		},
+		{
+			name:          "law stone client ask error",
+			addr:          "foo",
+			responseError: fmt.Errorf("error"),
+			wantErr:       fmt.Errorf("failed to query law-stone contract: error"),
+		},
+		{
+			name: "permitted with unbound evidence",
+			addr: "foo",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Variables: []string{"Result", "Evidence"},
+					Results: []lsschema.Result{
+						{
+							Substitutions: []lsschema.Substitution{
+								{Variable: "Result", Expression: "permitted"},
+								{Variable: "Evidence", Expression: "_1"},
+							},
+						},
+					},
+				},
+			},
+			wantResult: &dataverse.Decision{
+				GovAddr: "foo",
+				DID:     did,
+				Action:  "read",
+				Result:  prolog.Atom("permitted"),
+			},
+			wantPermitted: true,
+		},
+		{
+			name: "prohibited with evidence",
+			addr: "foo",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Variables: []string{"Result", "Evidence"},
+					Results: []lsschema.Result{
+						{
+							Substitutions: []lsschema.Substitution{
+								{Variable: "Result", Expression: "prohibited"},
+								{Variable: "Evidence", Expression: "[cause('not a member of zone','did:key:zone')]"},
+							},
+						},
+					},
+				},
+			},
+			wantResult: &dataverse.Decision{
+				GovAddr: "foo",
+				DID:     did,
+				Action:  "read",
+				Result:  prolog.Atom("prohibited"),
+				Evidence: prolog.List{Elements: []prolog.Term{
+					prolog.Compound{Functor: "cause", Args: []prolog.Term{
+						prolog.Atom("not a member of zone"),
+						prolog.Atom("did:key:zone"),
+					}},
+				}},
+			},
+			wantPermitted: false,
+		},
+		{
+			name: "no result",
+			addr: "foo",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{},
+				},
+			},
+			wantResult: &dataverse.Decision{
+				GovAddr: "foo",
+				DID:     did,
+				Action:  "read",
+			},
+			wantPermitted: false,
+		},
+		{
+			name: "several results",
+			addr: "foo",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Variables: []string{"Result", "Evidence"},
+					Results: []lsschema.Result{
+						{
+							Substitutions: []lsschema.Substitution{
+								{Variable: "Result", Expression: "permitted"},
+								{Variable: "Evidence", Expression: "_1"},
+							},
+						},
+						{
+							Substitutions: []lsschema.Substitution{
+								{Variable: "Result", Expression: "prohibited"},
+								{Variable: "Evidence", Expression: "_2"},
+							},
+						},
+					},
+				},
+			},
+			wantResult: &dataverse.Decision{
+				GovAddr: "foo",
+				DID:     did,
+				Action:  "read",
+			},
+			wantPermitted: false,
+		},
+		{
+			name: "error while deciding",
+			addr: "foo",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{
+						{Error: toAddress("error(existence_error(procedure,tell/4),root)")},
+					},
+				},
+			},
+			wantResult: &dataverse.Decision{
+				GovAddr: "foo",
+				DID:     did,
+				Action:  "read",
+				Error:   "error(existence_error(procedure,tell/4),root)",
+			},
+			wantPermitted: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a mocked law-stone client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				lawStoneMock := testutil.NewMockLawStoneQueryClient(controller)
+				if test.addr != "error" {
+					lawStoneMock.EXPECT().
+						Ask(gomock.Any(), gomock.Eq(&lsschema.QueryMsg_Ask{Query: fmt.Sprintf("tell('%s','read',Result,Evidence).", did)})).
+						Return(test.response, test.responseError).
+						Times(1)
+				}
+
+				client := dataverse.NewDataverseQueryClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					testutil.NewMockCognitariumQueryClient(controller),
+					func(addr string) (lsschema.QueryClient, error) {
+						if addr == "error" {
+							return nil, fmt.Errorf("error")
+						}
+
+						return lawStoneMock, nil
+					},
+				)
+
+				Convey("When AskGovDecision is called", func() {
+					decision, err := client.AskGovDecision(context.Background(), test.addr, did, "read")
+
+					Convey("Then the decision of the governance should be returned", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(decision, ShouldResemble, test.wantResult)
+							So(decision.Permitted(), ShouldEqual, test.wantPermitted)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(decision, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestDecision_String(t *testing.T) {
+	Convey("Given governance decisions", t, func() {
+		Convey("Then their description should give the result, evidence and error", func() {
+			So((&dataverse.Decision{
+				GovAddr:  "axone1gov",
+				DID:      "did:key:abc",
+				Action:   "read",
+				Result:   prolog.Atom("prohibited"),
+				Evidence: prolog.Compound{Functor: "cause", Args: []prolog.Term{prolog.Atom("expired")}},
+			}).String(), ShouldEqual,
+				"governance axone1gov decided prohibited for did:key:abc to read with evidence cause(expired)")
+			So((&dataverse.Decision{
+				GovAddr: "axone1gov",
+				DID:     "did:key:abc",
+				Action:  "read",
+				Error:   "error(gas)",
+			}).String(), ShouldEqual, "governance axone1gov decided no result for did:key:abc to read (error: error(gas))")
+		})
+	})
+}
@@ -0,0 +1,152 @@
+package dataverse
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axone-sdk/credential"
+	"github.com/axone-protocol/axone-sdk/tx"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/piprate/json-gold/ld"
+)
+
+const (
+	instantiateEventType    = "instantiate"
+	contractAddressEventKey = "_contract_address"
+	defaultGovernanceLabel  = "axone-governance"
+	txPollInterval          = time.Second
+)
+
+// GovernanceDescriptorFunc returns the descriptor of the credential linking a resource to the governance deployed at
+// the given address, such as template.NewGovernance bound to the resource DID.
+type GovernanceDescriptorFunc func(govAddr string) credential.Descriptor
+
+// GovernanceSpec describes the law-stone contract to instantiate to put a resource under governance.
+type GovernanceSpec struct {
+	// CodeID is the identifier of the law-stone contract code stored on chain.
+	CodeID uint64
+	// StorageAddr is the address of the objectarium contract in which the law-stone stores its program.
+	StorageAddr string
+	// Program is the Prolog program carrying the governance rules.
+	Program string
+	// Label of the contract instance. If empty, a default one is used.
+	Label string
+	// Admin is the address allowed to migrate the contract. The contract is not migratable if empty.
+	Admin string
+}
+
+// GovernanceDeployment is the result of putting a resource under a new governance.
+type GovernanceDeployment struct {
+	// GovAddr is the address of the instantiated law-stone contract.
+	GovAddr string
+	// InstantiateTx is the response of the law-stone instantiation transaction.
+	InstantiateTx *types.TxResponse
+	// Credential is the GovernanceTextCredential linking the resource to its governance.
+	Credential *verifiable.Credential
+	// SubmitTx is the response of the governance credential submission transaction.
+	SubmitTx *types.TxResponse
+}
+
+func (t *txClient) DeployGovernance(
+	ctx context.Context,
+	spec GovernanceSpec,
+	descriptor GovernanceDescriptorFunc,
+	documentLoader ld.DocumentLoader,
+) (*GovernanceDeployment, error) {
+	instantiateTx, err := t.instantiateLawStone(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	govAddr, err := contractAddress(instantiateTx.Events)
+	if err != nil {
+		return nil, err
+	}
+	deployment := &GovernanceDeployment{GovAddr: govAddr, InstantiateTx: instantiateTx}
+
+	deployment.Credential, err = credential.New(
+		descriptor(govAddr),
+		credential.WithParser(credential.NewDefaultParser(documentLoader)),
+		credential.WithSigner(t.signer),
+	).Generate()
+	if err != nil {
+		return deployment, NewDVError(ErrIssueCredential, err)
+	}
+
+	deployment.SubmitTx, err = t.submitClaims(ctx, deployment.Credential, documentLoader)
+	if err != nil {
+		return deployment, err
+	}
+
+	return deployment, nil
+}
+
+// instantiateLawStone instantiates a law-stone contract and waits for its instantiation to be included in a block.
+func (t *txClient) instantiateLawStone(ctx context.Context, spec GovernanceSpec) (*types.TxResponse, error) {
+	msg, err := json.Marshal(lsschema.InstantiateMsg{
+		Program:        lsschema.Binary(base64.StdEncoding.EncodeToString([]byte(spec.Program))),
+		StorageAddress: spec.StorageAddr,
+	})
+	if err != nil {
+		return nil, NewDVError(ErrMarshalJSON, err)
+	}
+
+	label := spec.Label
+	if label == "" {
+		label = defaultGovernanceLabel
+	}
+
+	resp, err := t.txClient.SendTx(ctx, tx.NewTransaction(t.txConfig,
+		tx.WithMsgs(&wasmtypes.MsgInstantiateContract{
+			Sender: t.signer.Addr(),
+			Admin:  spec.Admin,
+			CodeID: spec.CodeID,
+			Label:  label,
+			Msg:    msg,
+		}),
+		tx.WithSigner(t.signer),
+		tx.WithGasLimit(2000000),
+	))
+	if err != nil {
+		return nil, NewDVError(ErrSendTx, err)
+	}
+	if resp.Code != 0 {
+		return nil, NewDVError(ErrTxFailed, fmt.Errorf("code %d: %s", resp.Code, resp.RawLog))
+	}
+
+	getter, ok := t.txClient.(tx.Getter)
+	if !ok {
+		return nil, NewDVError(ErrNoTxGetter, nil)
+	}
+	resp, err = tx.WaitTx(ctx, getter, resp.TxHash, txPollInterval)
+	if err != nil {
+		return nil, NewDVError(ErrSendTx, err)
+	}
+	if resp.Code != 0 {
+		return nil, NewDVError(ErrTxFailed, fmt.Errorf("code %d: %s", resp.Code, resp.RawLog))
+	}
+
+	return resp, nil
+}
+
+// contractAddress returns the address of the contract instantiated by a transaction given its events.
+func contractAddress(events []abci.Event) (string, error) {
+	for _, event := range events {
+		if event.Type != instantiateEventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == contractAddressEventKey {
+				return attr.Value, nil
+			}
+		}
+	}
+	return "", NewDVError(ErrNoContractAddr, nil)
+}
@@ -0,0 +1,192 @@
+package dataverse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/axone-protocol/axone-sdk/credential"
+	"github.com/axone-protocol/axone-sdk/credential/template"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	"github.com/axone-protocol/axone-sdk/tx"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestClient_DeployGovernance(t *testing.T) {
+	const resourceDID = "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5"
+
+	instantiated := &types.TxResponse{
+		TxHash: "instantiateHash",
+		Events: []abci.Event{
+			{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "instantiate"}}},
+			{Type: "instantiate", Attributes: []abci.EventAttribute{
+				{Key: "code_id", Value: "5"},
+				{Key: "_contract_address", Value: "axone1lawstone"},
+			}},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		instantiateTx  *types.TxResponse
+		instantiateErr error
+		includedTx     *types.TxResponse
+		includedErr    error
+		submitErr      error
+		noTxGetter     bool
+		wantSubmit     bool
+		wantErr        error
+		wantGovAddr    string
+	}{
+		{
+			name:          "governance deployed",
+			instantiateTx: &types.TxResponse{TxHash: "instantiateHash"},
+			includedTx:    instantiated,
+			wantSubmit:    true,
+			wantGovAddr:   "axone1lawstone",
+		},
+		{
+			name:           "instantiation broadcast error",
+			instantiateErr: fmt.Errorf("insufficient fees"),
+			wantErr:        dataverse.NewDVError(dataverse.ErrSendTx, fmt.Errorf("insufficient fees")),
+		},
+		{
+			name:          "instantiation rejected",
+			instantiateTx: &types.TxResponse{TxHash: "instantiateHash", Code: 5, RawLog: "insufficient funds"},
+			wantErr:       dataverse.NewDVError(dataverse.ErrTxFailed, fmt.Errorf("code 5: insufficient funds")),
+		},
+		{
+			name:          "instantiation not retrieved",
+			instantiateTx: &types.TxResponse{TxHash: "instantiateHash"},
+			includedErr:   fmt.Errorf("connection refused"),
+			wantErr:       dataverse.NewDVError(dataverse.ErrSendTx, fmt.Errorf("connection refused")),
+		},
+		{
+			name:          "instantiation failed",
+			instantiateTx: &types.TxResponse{TxHash: "instantiateHash"},
+			includedTx:    &types.TxResponse{TxHash: "instantiateHash", Code: 5, RawLog: "invalid program"},
+			wantErr:       dataverse.NewDVError(dataverse.ErrTxFailed, fmt.Errorf("code 5: invalid program")),
+		},
+		{
+			name:          "no contract address in events",
+			instantiateTx: &types.TxResponse{TxHash: "instantiateHash"},
+			includedTx:    &types.TxResponse{TxHash: "instantiateHash"},
+			wantErr:       dataverse.NewDVError(dataverse.ErrNoContractAddr, nil),
+		},
+		{
+			name:          "credential submission error",
+			instantiateTx: &types.TxResponse{TxHash: "instantiateHash"},
+			includedTx:    instantiated,
+			submitErr:     fmt.Errorf("insufficient fees"),
+			wantSubmit:    true,
+			wantErr:       dataverse.NewDVError(dataverse.ErrSendTx, fmt.Errorf("insufficient fees")),
+			wantGovAddr:   "axone1lawstone",
+		},
+		{
+			name:          "tx client unable to get transactions",
+			instantiateTx: &types.TxResponse{TxHash: "instantiateHash"},
+			noTxGetter:    true,
+			wantErr:       dataverse.NewDVError(dataverse.ErrNoTxGetter, nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a dataverse tx client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				txConfig, err := tx.MakeDefaultTxConfig()
+				So(err, ShouldBeNil)
+
+				mockKeyring := testutil.NewMockKeyring(controller)
+				mockKeyring.EXPECT().Addr().Return("axone1signer").AnyTimes()
+				mockKeyring.EXPECT().DID().Return(resourceDID).AnyTimes()
+				mockKeyring.EXPECT().DIDKeyID().Return(resourceDID + "#key").AnyTimes()
+				mockKeyring.EXPECT().Alg().Return("secp256k1").AnyTimes()
+				mockKeyring.EXPECT().Sign(gomock.Any()).Return([]byte("signature"), nil).AnyTimes()
+
+				mockTxClient := testutil.NewMockTxClient(controller)
+				mockTxGetter := testutil.NewMockTxGetter(controller)
+				sendCalls := mockTxClient.EXPECT().
+					SendTx(gomock.Any(), gomock.Any()).
+					Return(test.instantiateTx, test.instantiateErr)
+				if test.instantiateErr == nil && test.instantiateTx.Code == 0 && !test.noTxGetter {
+					mockTxGetter.EXPECT().
+						GetTx(gomock.Any(), "instantiateHash").
+						Return(test.includedTx, test.includedErr)
+				}
+				var txClient tx.Client = txClientGetter{mockTxClient, mockTxGetter}
+				if test.noTxGetter {
+					txClient = mockTxClient
+				}
+				if test.wantSubmit {
+					submitTx := &types.TxResponse{TxHash: "submitHash"}
+					if test.submitErr != nil {
+						submitTx = nil
+					}
+					mockTxClient.EXPECT().
+						SendTx(gomock.Any(), gomock.Any()).
+						Return(submitTx, test.submitErr).
+						After(sendCalls)
+				}
+
+				loader, err := testutil.MockDocumentLoader()
+				So(err, ShouldBeNil)
+
+				client := dataverse.NewDataverseTxClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					testutil.NewMockCognitariumQueryClient(controller),
+					nil,
+					txClient,
+					txConfig,
+					mockKeyring,
+				)
+
+				Convey("When DeployGovernance is called", func() {
+					deployment, err := client.DeployGovernance(
+						context.Background(),
+						dataverse.GovernanceSpec{
+							CodeID:      5,
+							StorageAddr: "axone1objectarium",
+							Program:     "tell(_, _, permitted, _).",
+						},
+						func(addr string) credential.Descriptor {
+							return template.NewGovernance(resourceDID, "contract:law-stone:"+addr)
+						},
+						loader,
+					)
+
+					Convey("Then the resource should be put under the new governance", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(deployment.InstantiateTx, ShouldEqual, instantiated)
+							So(deployment.SubmitTx.TxHash, ShouldEqual, "submitHash")
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+						}
+
+						if test.wantGovAddr == "" {
+							So(deployment, ShouldBeNil)
+						} else {
+							So(deployment.GovAddr, ShouldEqual, test.wantGovAddr)
+							So(deployment.Credential.Types, ShouldContain, "GovernanceTextCredential")
+							So(deployment.Credential.Issuer.ID, ShouldEqual, resourceDID)
+							So(deployment.Credential.Proofs, ShouldHaveLength, 1)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+// txClientGetter is a tx client able to get the transactions included in blocks, as the one created by tx.NewClient.
+type txClientGetter struct {
+	*testutil.MockTxClient
+	*testutil.MockTxGetter
+}
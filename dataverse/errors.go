@@ -5,13 +5,42 @@ import "fmt"
 type MessageError string
 
 const (
-	ErrNoResult    MessageError = "no result found in binding"
-	ErrVarNotFound MessageError = "variable not found in binding result"
-	ErrType        MessageError = "variable result type mismatch in binding result"
+	ErrNoResult     MessageError = "no result found in binding"
+	ErrVarNotFound  MessageError = "variable not found in binding result"
+	ErrType         MessageError = "variable result type mismatch in binding result"
+	ErrParseTerm    MessageError = "could not parse Prolog term in governance answer"
+	ErrBrokenGov    MessageError = "governance is broken and no longer active"
+	ErrQueryLimit   MessageError = "results exceed the maximum query limit of the cognitarium"
+	ErrAmbiguousGov MessageError = "resource inherits several governances from its zones"
+
+	ErrCompileProgram MessageError = "could not compile governance program"
+	ErrParseProgram   MessageError = "could not parse governance program"
+
+	ErrUnsupportedFormat MessageError = "unsupported RDF format"
+	ErrDecodeGraph       MessageError = "could not decode RDF graph"
+	ErrNoData            MessageError = "no RDF data provided"
+	ErrNoPattern         MessageError = "no triple pattern provided"
+	ErrInvalidPattern    MessageError = "invalid triple pattern"
 
 	ErrConvertRDF  MessageError = "could not convert credential to RDF"
 	ErrMarshalJSON MessageError = "could not marshal JSON message"
 	ErrSendTx      MessageError = "could not send transaction"
+	ErrSimulateTx  MessageError = "could not simulate transaction"
+	ErrTxFailed    MessageError = "transaction failed"
+
+	ErrInvalidShape  MessageError = "invalid SHACL shape"
+	ErrInvalidClaims MessageError = "claims do not conform to the ontology shapes"
+
+	ErrNoContractAddr  MessageError = "no contract address found in transaction events"
+	ErrNoTxGetter      MessageError = "tx client cannot get the transactions included in blocks"
+	ErrNoTxSimulator   MessageError = "tx client cannot simulate transactions"
+	ErrIssueCredential MessageError = "could not issue governance credential"
+
+	ErrNoIdentifier MessageError = "no credential identifier provided"
+
+	ErrUnknownDataverse    MessageError = "no dataverse registered with this name"
+	ErrDataverseRegistered MessageError = "a dataverse is already registered with this name"
+	ErrRegistryClosed      MessageError = "registry of dataverses is closed"
 )
 
 type DVError struct {
@@ -26,6 +55,11 @@ func (e *DVError) Error() string {
 	return fmt.Sprintf("%v: %v", e.message, e.detail)
 }
 
+// Unwrap returns the detail of the error, e.g. a shacl.ValidationError carrying the validation report of claims.
+func (e *DVError) Unwrap() error {
+	return e.detail
+}
+
 func NewDVError(message MessageError, detail error) error {
 	return &DVError{
 		message: message,
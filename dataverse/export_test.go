@@ -1,11 +1,17 @@
 package dataverse
 
 import (
+	"context"
+	"time"
+
 	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
 	dvschema "github.com/axone-protocol/axone-contract-schema/go/dataverse-schema/v6"
 	"github.com/axone-protocol/axone-sdk/keys"
 	"github.com/axone-protocol/axone-sdk/tx"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/piprate/json-gold/ld"
 )
 
 func NewDataverseQueryClient(
@@ -19,6 +25,7 @@ func NewDataverseQueryClient(
 		dataverseClient,
 		cognitariumClient,
 		lawStoneFactory,
+		nil,
 	}
 }
 
@@ -37,11 +44,34 @@ func NewDataverseTxClient(
 			dataverseClient,
 			cognitariumClient,
 			lawStoneFactory,
+			nil,
+		},
+		executor: executor{
+			txClient: client,
+			txConfig: txConfig,
+			signer:   signer,
 		},
-		txClient: client,
-		txConfig: txConfig,
-		signer:   signer,
 	}
 }
 
 var GetCognitariumAddr = getCognitariumAddr
+
+var BuildDeleteDataMsg = buildDeleteDataMsg
+
+func WithClock(now func() time.Time) CacheOption {
+	return func(c *CachingQueryClient) {
+		c.now = now
+	}
+}
+
+// SubmitClaimsWithLoader submits claims as SubmitClaims does, resolving the JSON-LD contexts of the credential with the
+// given document loader.
+func SubmitClaimsWithLoader(
+	ctx context.Context,
+	c TxClient,
+	vc *verifiable.Credential,
+	documentLoader ld.DocumentLoader,
+	opts ...SubmitOption,
+) (*types.TxResponse, error) {
+	return c.(*txClient).submitClaims(ctx, vc, documentLoader, opts...)
+}
@@ -3,15 +3,179 @@ package dataverse
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
 	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axone-sdk/prolog"
 )
 
 func (c *queryClient) GetResourceGovAddr(ctx context.Context, resourceDID string) (string, error) {
-	query := buildGetResourceGovAddrRequest(resourceDID)
+	addr, err := c.selectGovAddr(ctx, buildGetResourceGovAddrRequest(resourceDID))
+	if isNoResult(err) {
+		addr, err = c.selectZoneGovAddr(ctx, resourceDID)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return addr, nil
+}
+
+// selectZoneGovAddr returns the governance address the given resource inherits from the zones it is a member of, which
+// must all share the same governance for it to be unambiguous.
+func (c *queryClient) selectZoneGovAddr(ctx context.Context, resourceDID string) (string, error) {
+	response, err := c.cognitariumClient.Select(ctx, &cgschema.QueryMsg_Select{
+		Query: buildGetZoneGovAddrRequest(resourceDID),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	addrs := make([]string, 0, 1)
+	for _, binding := range response.Results.Bindings {
+		addr, err := govAddrFromBinding(binding)
+		if err != nil {
+			return "", err
+		}
+		if !slices.Contains(addrs, addr) {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	return zoneGovAddr(addrs)
+}
+
+// zoneGovAddr returns the single governance address among the distinct ones a resource inherits from its zones.
+func zoneGovAddr(addrs []string) (string, error) {
+	switch len(addrs) {
+	case 0:
+		return "", NewDVError(ErrNoResult, nil)
+	case 1:
+		return addrs[0], nil
+	default:
+		return "", NewDVError(ErrAmbiguousGov, fmt.Errorf("%s", strings.Join(addrs, ", ")))
+	}
+}
+
+// isNoResult tells if the given error reports that a query has no result, e.g. a resource without governance.
+func isNoResult(err error) bool {
+	var dvErr *DVError
+	return errors.As(err, &dvErr) && dvErr.message == ErrNoResult
+}
+
+// getResourcesGovAddrs resolves the governance addresses of the given resources, indexed by resource, as
+// GetResourceGovAddr does but in a select query for the governances of the resources and another one for the
+// governances inherited from their zones, as long as their bindings fit in the maximum query limit of the cognitarium.
+func (c *queryClient) getResourcesGovAddrs(ctx context.Context, resources []string) (map[string]string, error) {
+	if len(resources) == 0 {
+		return map[string]string{}, nil
+	}
+
+	maxLimit, err := c.maxQueryLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs, err := c.selectResourcesGovAddrs(ctx, resources, maxLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	var zoned []string
+	for _, resource := range resources {
+		if _, ok := addrs[resource]; !ok {
+			zoned = append(zoned, resource)
+		}
+	}
+	zoneAddrs, err := c.selectZonesGovAddrs(ctx, zoned, maxLimit)
+	if err != nil {
+		return nil, err
+	}
+	for _, resource := range zoned {
+		addr, err := zoneGovAddr(zoneAddrs[resource])
+		if isNoResult(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get governance of resource %s: %w", resource, err)
+		}
+		addrs[resource] = addr
+	}
+
+	return addrs, nil
+}
+
+// selectResourcesGovAddrs selects the governance addresses of the given resources, indexed by resource. A resource
+// having several governances resolves to the first one, as the query of GetResourceGovAddr limited to 1 binding does.
+func (c *queryClient) selectResourcesGovAddrs(
+	ctx context.Context,
+	resources []string,
+	maxLimit int,
+) (map[string]string, error) {
+	bindings, err := c.selectByIRIs(ctx, resources, maxLimit, buildGetResourcesGovAddrRequest, func(string) error {
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make(map[string]string, len(resources))
+	for _, binding := range bindings {
+		resource, err := bindingIRI(binding, "resource")
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := addrs[resource]; ok {
+			continue
+		}
+		if addrs[resource], err = govAddrFromBinding(binding); err != nil {
+			return nil, err
+		}
+	}
+
+	return addrs, nil
+}
+
+// selectZonesGovAddrs selects the distinct governance addresses the given resources inherit from their zones, indexed
+// by resource.
+func (c *queryClient) selectZonesGovAddrs(
+	ctx context.Context,
+	resources []string,
+	maxLimit int,
+) (map[string][]string, error) {
+	bindings, err := c.selectByIRIs(ctx, resources, maxLimit, buildGetZonesGovAddrRequest, func(resource string) error {
+		return fmt.Errorf("failed to get governance of resource %s: %w",
+			resource, NewDVError(ErrQueryLimit, fmt.Errorf("more than %d zone governances", maxLimit)))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make(map[string][]string, len(resources))
+	for _, binding := range bindings {
+		resource, err := bindingIRI(binding, "resource")
+		if err != nil {
+			return nil, err
+		}
+		addr, err := govAddrFromBinding(binding)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(addrs[resource], addr) {
+			addrs[resource] = append(addrs[resource], addr)
+		}
+	}
+
+	return addrs, nil
+}
+
+// selectGovAddr runs a query selecting the governance code IRI of a resource and returns the corresponding law-stone
+// contract address.
+func (c *queryClient) selectGovAddr(ctx context.Context, query cgschema.SelectQuery) (string, error) {
 	response, err := c.cognitariumClient.Select(ctx, &cgschema.QueryMsg_Select{Query: query})
 	if err != nil {
 		return "", err
@@ -21,18 +185,18 @@ func (c *queryClient) GetResourceGovAddr(ctx context.Context, resourceDID string
 		return "", NewDVError(ErrNoResult, nil)
 	}
 
-	codeBinding, ok := response.Results.Bindings[0]["code"]
-	if !ok {
-		return "", NewDVError(ErrVarNotFound, nil)
-	}
-	code, ok := codeBinding.ValueType.(cgschema.URI)
-	if !ok {
-		return "", NewDVError(ErrType, fmt.Errorf("expected URI, got %T", codeBinding.ValueType))
+	return govAddrFromBinding(response.Results.Bindings[0])
+}
+
+// govAddrFromBinding returns the law-stone contract address of the governance code IRI bound to the `code` variable.
+func govAddrFromBinding(binding map[string]cgschema.Value) (string, error) {
+	codeURI, err := bindingIRI(binding, "code")
+	if err != nil {
+		return "", err
 	}
 
-	codeURI := string(*code.Value.Full)
 	addr := codeURI
-	if i := strings.LastIndex(string(*code.Value.Full), ":"); i != -1 {
+	if i := strings.LastIndex(codeURI, ":"); i != -1 {
 		addr = codeURI[i+1:]
 	}
 
@@ -62,50 +226,41 @@ func (c *queryClient) GovCode(ctx context.Context, addr string) (string, error)
 }
 
 func (c *queryClient) AskGovPermittedActions(ctx context.Context, addr, did string) ([]string, error) {
-	gov, err := c.lawStoneFactory(addr)
+	query, err := prolog.NewQuery().
+		Goal("tell_permitted_actions", prolog.Atom(did), prolog.Variable("Actions")).
+		Build()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create law-stone client: %w", err)
+		return nil, fmt.Errorf("failed to build governance query: %w", err)
 	}
 
-	response, err := gov.Ask(ctx, &lsschema.QueryMsg_Ask{Query: fmt.Sprintf("tell_permitted_actions('%s',Actions).", did)})
+	answer, err := c.AskGov(ctx, addr, query)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query law-stone contract: %w", err)
+		return nil, err
 	}
 
-	if len(response.Answer.Results) != 1 {
+	term, ok := answer.singleTerm()
+	if !ok {
 		return nil, nil
 	}
-	if len(response.Answer.Results[0].Substitutions) != 1 {
-		return nil, nil
+
+	list, ok := term.(prolog.List)
+	if !ok {
+		return nil, NewDVError(ErrType, fmt.Errorf("expected list of actions, got %s", term))
 	}
 
-	result := response.Answer.Results[0].Substitutions[0].Expression
-	result = result[1 : len(result)-1]
-	actions := make([]string, 0)
-	for _, action := range strings.Split(result, ",") {
-		actions = append(actions, strings.Trim(action, "'"))
+	actions := make([]string, 0, len(list.Elements))
+	for _, action := range list.Elements {
+		actions = append(actions, prolog.Text(action))
 	}
 
 	return actions, nil
 }
 
 func (c *queryClient) AskGovTellAction(ctx context.Context, addr, did, action string) (bool, error) {
-	gov, err := c.lawStoneFactory(addr)
-	if err != nil {
-		return false, fmt.Errorf("failed to create law-stone client: %w", err)
-	}
-
-	response, err := gov.Ask(ctx, &lsschema.QueryMsg_Ask{Query: fmt.Sprintf("tell('%s','%s',Result,_).", did, action)})
+	decision, err := c.AskGovDecision(ctx, addr, did, action)
 	if err != nil {
-		return false, fmt.Errorf("failed to query law-stone contract: %w", err)
-	}
-
-	if len(response.Answer.Results) != 1 {
-		return false, nil
-	}
-	if len(response.Answer.Results[0].Substitutions) != 1 {
-		return false, nil
+		return false, err
 	}
 
-	return response.Answer.Results[0].Substitutions[0].Expression == "permitted", nil
+	return decision.Permitted(), nil
 }
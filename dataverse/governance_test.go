@@ -23,6 +23,7 @@ func TestClient_GetResourceGovAddr(t *testing.T) {
 		resourceDID   string
 		response      *cgschema.SelectResponse
 		responseError error
+		zoneResponse  *cgschema.SelectResponse
 		wantErr       error
 		wantResult    string
 	}{
@@ -113,8 +114,79 @@ func TestClient_GetResourceGovAddr(t *testing.T) {
 				},
 			},
 			responseError: nil,
-			wantErr:       dataverse.NewDVError(dataverse.ErrNoResult, nil),
-			wantResult:    "",
+			zoneResponse: &cgschema.SelectResponse{
+				Head: cgschema.Head{
+					Vars: []string{"code"},
+				},
+				Results: cgschema.Results{
+					Bindings: []map[string]cgschema.Value{},
+				},
+			},
+			wantErr:    dataverse.NewDVError(dataverse.ErrNoResult, nil),
+			wantResult: "",
+		},
+		{
+			name:        "governance inherited from zone",
+			resourceDID: "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+			response: &cgschema.SelectResponse{
+				Head: cgschema.Head{
+					Vars: []string{"code"},
+				},
+				Results: cgschema.Results{
+					Bindings: []map[string]cgschema.Value{},
+				},
+			},
+			responseError: nil,
+			zoneResponse: &cgschema.SelectResponse{
+				Head: cgschema.Head{
+					Vars: []string{"code"},
+				},
+				Results: cgschema.Results{
+					Bindings: []map[string]cgschema.Value{
+						{
+							"code": uriValue("contract:law-stone:zone"),
+						},
+					},
+				},
+			},
+			wantErr:    nil,
+			wantResult: "zone",
+		},
+		{
+			name:        "governance inherited from several zones sharing it",
+			resourceDID: "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+			response: &cgschema.SelectResponse{
+				Results: cgschema.Results{
+					Bindings: []map[string]cgschema.Value{},
+				},
+			},
+			zoneResponse: &cgschema.SelectResponse{
+				Results: cgschema.Results{
+					Bindings: []map[string]cgschema.Value{
+						{"code": uriValue("contract:law-stone:zone")},
+						{"code": uriValue("contract:law-stone:zone")},
+					},
+				},
+			},
+			wantResult: "zone",
+		},
+		{
+			name:        "governance ambiguously inherited from zones",
+			resourceDID: "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+			response: &cgschema.SelectResponse{
+				Results: cgschema.Results{
+					Bindings: []map[string]cgschema.Value{},
+				},
+			},
+			zoneResponse: &cgschema.SelectResponse{
+				Results: cgschema.Results{
+					Bindings: []map[string]cgschema.Value{
+						{"code": uriValue("contract:law-stone:zone1")},
+						{"code": uriValue("contract:law-stone:zone2")},
+					},
+				},
+			},
+			wantErr: dataverse.NewDVError(dataverse.ErrAmbiguousGov, fmt.Errorf("zone1, zone2")),
 		},
 		{
 			name:        "invalid value type in response",
@@ -156,6 +228,18 @@ func TestClient_GetResourceGovAddr(t *testing.T) {
 					Select(gomock.Any(), gomock.Any()).
 					Return(test.response, test.responseError).
 					Times(1)
+				if test.zoneResponse != nil {
+					mockCognitarium.
+						EXPECT().
+						Select(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, req *cgschema.QueryMsg_Select, _ ...any) (*cgschema.SelectResponse, error) {
+							// Only the memberships issued by the zone or by the resource itself are considered.
+							So(req.Query.Limit, ShouldBeNil)
+							So(*req.Query.Where.Filter.Expr.Or, ShouldHaveLength, 2)
+							return test.zoneResponse, nil
+						}).
+						Times(1)
+				}
 
 				client := dataverse.NewDataverseQueryClient(
 					mockDataverseClient,
@@ -186,6 +270,7 @@ func TestClient_AskGovPermittedActions(t *testing.T) {
 		name          string
 		addr          string
 		did           string
+		query         string
 		response      *lsschema.AskResponse
 		responseError error
 		wantErr       error
@@ -302,6 +387,125 @@ func TestClient_AskGovPermittedActions(t *testing.T) {
 			wantErr:       nil,
 			wantResult:    []string{"read", "store"},
 		},
+		{
+			name: "quoted actions containing commas in response",
+			addr: "foo",
+			did:  "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{
+						{
+							Substitutions: []lsschema.Substitution{
+								{
+									Expression: "['read, then store','it''s']",
+								},
+							},
+						},
+					},
+				},
+			},
+			responseError: nil,
+			wantErr:       nil,
+			wantResult:    []string{"read, then store", "it's"},
+		},
+		{
+			name: "compound actions in response",
+			addr: "foo",
+			did:  "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{
+						{
+							Substitutions: []lsschema.Substitution{
+								{
+									Expression: "[read,store('s3',[a,b])]",
+								},
+							},
+						},
+					},
+				},
+			},
+			responseError: nil,
+			wantErr:       nil,
+			wantResult:    []string{"read", "store(s3,[a,b])"},
+		},
+		{
+			name: "empty list of actions in response",
+			addr: "foo",
+			did:  "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{
+						{
+							Substitutions: []lsschema.Substitution{
+								{
+									Expression: "[]",
+								},
+							},
+						},
+					},
+				},
+			},
+			responseError: nil,
+			wantErr:       nil,
+			wantResult:    []string{},
+		},
+		{
+			name: "non list actions in response",
+			addr: "foo",
+			did:  "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{
+						{
+							Substitutions: []lsschema.Substitution{
+								{
+									Expression: "read",
+								},
+							},
+						},
+					},
+				},
+			},
+			responseError: nil,
+			wantErr:       dataverse.NewDVError(dataverse.ErrType, fmt.Errorf("expected list of actions, got read")),
+			wantResult:    nil,
+		},
+		{
+			name: "malformed actions in response",
+			addr: "foo",
+			did:  "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{
+						{
+							Substitutions: []lsschema.Substitution{
+								{
+									Expression: "[read,store",
+								},
+							},
+						},
+					},
+				},
+			},
+			responseError: nil,
+			wantErr:       dataverse.NewDVError(dataverse.ErrParseTerm, fmt.Errorf("syntax error at offset 11: unexpected end of input")),
+			wantResult:    nil,
+		},
+		{
+			name:  "did with quotes is escaped in query",
+			addr:  "foo",
+			did:   "did:key:abc',Actions),Actions=['admin'],true;foo('",
+			query: `tell_permitted_actions('did:key:abc\',Actions),Actions=[\'admin\'],true;foo(\'',Actions).`,
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{},
+				},
+			},
+			responseError: nil,
+			wantErr:       nil,
+			wantResult:    nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -310,10 +514,15 @@ func TestClient_AskGovPermittedActions(t *testing.T) {
 				controller := gomock.NewController(t)
 				defer controller.Finish()
 
+				query := test.query
+				if query == "" {
+					query = fmt.Sprintf("tell_permitted_actions('%s',Actions).", test.did)
+				}
+
 				lawStoneMock := testutil.NewMockLawStoneQueryClient(controller)
 				if test.addr != "error" {
 					lawStoneMock.EXPECT().
-						Ask(gomock.Any(), gomock.Eq(&lsschema.QueryMsg_Ask{Query: fmt.Sprintf("tell_permitted_actions('%s',Actions).", test.did)})).
+						Ask(gomock.Any(), gomock.Eq(&lsschema.QueryMsg_Ask{Query: query})).
 						Return(test.response, test.responseError).
 						Times(1)
 				}
@@ -354,6 +563,7 @@ func TestClient_AskGovTellAction(t *testing.T) {
 		addr          string
 		did           string
 		action        string
+		query         string
 		response      *lsschema.AskResponse
 		responseError error
 		wantErr       error
@@ -422,6 +632,7 @@ func TestClient_AskGovTellAction(t *testing.T) {
 						{
 							Substitutions: []lsschema.Substitution{
 								{
+									Variable:   "Result",
 									Expression: "permitted",
 								},
 							},
@@ -444,6 +655,7 @@ func TestClient_AskGovTellAction(t *testing.T) {
 						{
 							Substitutions: []lsschema.Substitution{
 								{
+									Variable:   "Result",
 									Expression: "prohibited",
 								},
 							},
@@ -455,6 +667,21 @@ func TestClient_AskGovTellAction(t *testing.T) {
 			wantErr:       nil,
 			wantResult:    false,
 		},
+		{
+			name:   "action with quotes is escaped in query",
+			addr:   "foo",
+			did:    "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+			action: "read',permitted,_),Result=permitted;tell('x",
+			query:  `tell('did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5','read\',permitted,_),Result=permitted;tell(\'x',Result,Evidence).`,
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{},
+				},
+			},
+			responseError: nil,
+			wantErr:       nil,
+			wantResult:    false,
+		},
 	}
 
 	for _, test := range tests {
@@ -463,10 +690,15 @@ func TestClient_AskGovTellAction(t *testing.T) {
 				controller := gomock.NewController(t)
 				defer controller.Finish()
 
+				query := test.query
+				if query == "" {
+					query = fmt.Sprintf("tell('%s','%s',Result,Evidence).", test.did, test.action)
+				}
+
 				lawStoneMock := testutil.NewMockLawStoneQueryClient(controller)
 				if test.addr != "error" {
 					lawStoneMock.EXPECT().
-						Ask(gomock.Any(), gomock.Eq(&lsschema.QueryMsg_Ask{Query: fmt.Sprintf("tell('%s','%s',Result,_).", test.did, test.action)})).
+						Ask(gomock.Any(), gomock.Eq(&lsschema.QueryMsg_Ask{Query: query})).
 						Return(test.response, test.responseError).
 						Times(1)
 				}
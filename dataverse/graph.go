@@ -0,0 +1,114 @@
+package dataverse
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+	"github.com/piprate/json-gold/ld"
+)
+
+// RDFFormat denotes a serialization format of an RDF graph returned by the dataverse.
+type RDFFormat string
+
+const (
+	// RDFFormatNTriples serializes the graph in [N-Triples](https://www.w3.org/TR/n-triples/) format.
+	RDFFormatNTriples RDFFormat = "n_triples"
+	// RDFFormatTurtle serializes the graph in [Turtle](https://www.w3.org/TR/turtle/) format.
+	RDFFormatTurtle RDFFormat = "turtle"
+	// RDFFormatJSONLD serializes the graph in [JSON-LD](https://www.w3.org/TR/json-ld11/) expanded form.
+	// As the cognitarium does not support it natively, the graph is retrieved in N-Triples and converted locally.
+	RDFFormatJSONLD RDFFormat = "json_ld"
+	// RDFFormatRDFXML serializes the graph in [RDF/XML](https://www.w3.org/TR/rdf-syntax-grammar/) format.
+	RDFFormatRDFXML RDFFormat = "rdf_xml"
+)
+
+// dataFormat returns the cognitarium format to request in order to produce the given RDFFormat.
+func (f RDFFormat) dataFormat() (cgschema.DataFormat, error) {
+	switch f {
+	case RDFFormatNTriples, RDFFormatJSONLD:
+		return cgschema.DataFormat_NTriples, nil
+	case RDFFormatTurtle:
+		return cgschema.DataFormat_Turtle, nil
+	case RDFFormatRDFXML:
+		return cgschema.DataFormat_RdfXml, nil
+	default:
+		return "", NewDVError(ErrUnsupportedFormat, fmt.Errorf("%s", f))
+	}
+}
+
+// insertFormat returns the cognitarium format of data serialized in the given RDFFormat to be inserted.
+func (f RDFFormat) insertFormat() (cgschema.DataFormat, error) {
+	switch f {
+	case RDFFormatNTriples:
+		return cgschema.DataFormat_NTriples, nil
+	case RDFFormatTurtle:
+		return cgschema.DataFormat_Turtle, nil
+	case RDFFormatRDFXML:
+		return cgschema.DataFormat_RdfXml, nil
+	default:
+		return "", NewDVError(ErrUnsupportedFormat, fmt.Errorf("%s", f))
+	}
+}
+
+func (c *queryClient) DescribeResource(ctx context.Context, resourceDID string, format RDFFormat) ([]byte, error) {
+	dataFormat, err := format.dataFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.cognitariumClient.Describe(ctx, &cgschema.QueryMsg_Describe{
+		Format: &dataFormat,
+		Query:  buildDescribeResourceRequest(resourceDID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return serializeGraph(response.Data, format)
+}
+
+func (c *queryClient) ConstructResource(ctx context.Context, resourceDID string, format RDFFormat) ([]byte, error) {
+	dataFormat, err := format.dataFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.cognitariumClient.Construct(ctx, &cgschema.QueryMsg_Construct{
+		Format: &dataFormat,
+		Query:  buildConstructResourceRequest(resourceDID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return serializeGraph(response.Data, format)
+}
+
+// serializeGraph decodes the graph data returned by the cognitarium and converts it to the expected format if needed.
+func serializeGraph(data cgschema.Binary, format RDFFormat) ([]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(string(data))
+	if err != nil {
+		return nil, NewDVError(ErrDecodeGraph, err)
+	}
+
+	if format != RDFFormatJSONLD {
+		return raw, nil
+	}
+
+	options := ld.NewJsonLdOptions("")
+	options.Format = "application/n-quads"
+	doc, err := ld.NewJsonLdProcessor().FromRDF(string(raw), options)
+	if err != nil {
+		return nil, NewDVError(ErrDecodeGraph, err)
+	}
+
+	jsonld, err := json.Marshal(doc)
+	if err != nil {
+		return nil, NewDVError(ErrMarshalJSON, err)
+	}
+
+	return jsonld, nil
+}
@@ -0,0 +1,233 @@
+package dataverse_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+const graphNTriples = `<did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5> <https://w3id.org/axone/ontology/v4/schema/credential/dataset/description/hasTitle> "title" .
+`
+
+func TestClient_DescribeResource(t *testing.T) {
+	tests := []struct {
+		name          string
+		format        dataverse.RDFFormat
+		wantFormat    *cgschema.DataFormat
+		response      *cgschema.DescribeResponse
+		responseError error
+		wantErr       error
+		wantResult    string
+	}{
+		{
+			name:       "describe as n-triples",
+			format:     dataverse.RDFFormatNTriples,
+			wantFormat: toAddress(cgschema.DataFormat_NTriples),
+			response: &cgschema.DescribeResponse{
+				Data:   cgschema.Binary(base64.StdEncoding.EncodeToString([]byte(graphNTriples))),
+				Format: cgschema.DataFormat_NTriples,
+			},
+			wantResult: graphNTriples,
+		},
+		{
+			name:       "describe as turtle",
+			format:     dataverse.RDFFormatTurtle,
+			wantFormat: toAddress(cgschema.DataFormat_Turtle),
+			response: &cgschema.DescribeResponse{
+				Data:   cgschema.Binary(base64.StdEncoding.EncodeToString([]byte("turtle"))),
+				Format: cgschema.DataFormat_Turtle,
+			},
+			wantResult: "turtle",
+		},
+		{
+			name:       "describe as json-ld",
+			format:     dataverse.RDFFormatJSONLD,
+			wantFormat: toAddress(cgschema.DataFormat_NTriples),
+			response: &cgschema.DescribeResponse{
+				Data:   cgschema.Binary(base64.StdEncoding.EncodeToString([]byte(graphNTriples))),
+				Format: cgschema.DataFormat_NTriples,
+			},
+			wantResult: `[{"@id":"did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",` +
+				`"https://w3id.org/axone/ontology/v4/schema/credential/dataset/description/hasTitle":[{"@value":"title"}]}]`,
+		},
+		{
+			name:       "describe as rdf/xml",
+			format:     dataverse.RDFFormatRDFXML,
+			wantFormat: toAddress(cgschema.DataFormat_RdfXml),
+			response: &cgschema.DescribeResponse{
+				Data:   cgschema.Binary(base64.StdEncoding.EncodeToString([]byte("<rdf:RDF/>"))),
+				Format: cgschema.DataFormat_RdfXml,
+			},
+			wantResult: "<rdf:RDF/>",
+		},
+		{
+			name:    "unsupported format",
+			format:  dataverse.RDFFormat("n_quads"),
+			wantErr: dataverse.NewDVError(dataverse.ErrUnsupportedFormat, fmt.Errorf("n_quads")),
+		},
+		{
+			name:          "grpc error",
+			format:        dataverse.RDFFormatTurtle,
+			wantFormat:    toAddress(cgschema.DataFormat_Turtle),
+			responseError: fmt.Errorf("gRPC: connection refused"),
+			wantErr:       fmt.Errorf("gRPC: connection refused"),
+		},
+		{
+			name:       "invalid base64 data",
+			format:     dataverse.RDFFormatTurtle,
+			wantFormat: toAddress(cgschema.DataFormat_Turtle),
+			response: &cgschema.DescribeResponse{
+				Data:   "not base64",
+				Format: cgschema.DataFormat_Turtle,
+			},
+			wantErr: dataverse.NewDVError(dataverse.ErrDecodeGraph, fmt.Errorf("illegal base64 data at input byte 3")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a mocked cognitarium client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				mockCognitarium := testutil.NewMockCognitariumQueryClient(controller)
+				if test.wantFormat != nil {
+					mockCognitarium.
+						EXPECT().
+						Describe(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, req *cgschema.QueryMsg_Describe, _ ...any) (*cgschema.DescribeResponse, error) {
+							So(req.Format, ShouldResemble, test.wantFormat)
+							So(req.Query.Resource.Variable, ShouldNotBeNil)
+							return test.response, test.responseError
+						}).
+						Times(1)
+				}
+
+				client := dataverse.NewDataverseQueryClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					mockCognitarium,
+					nil,
+				)
+
+				Convey("When DescribeResource is called", func() {
+					graph, err := client.DescribeResource(
+						context.Background(),
+						"did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+						test.format,
+					)
+
+					Convey("Then the serialized graph should be returned", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(string(graph), ShouldEqual, test.wantResult)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(graph, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestClient_ConstructResource(t *testing.T) {
+	tests := []struct {
+		name          string
+		format        dataverse.RDFFormat
+		wantFormat    *cgschema.DataFormat
+		response      *cgschema.ConstructResponse
+		responseError error
+		wantErr       error
+		wantResult    string
+	}{
+		{
+			name:       "construct as n-triples",
+			format:     dataverse.RDFFormatNTriples,
+			wantFormat: toAddress(cgschema.DataFormat_NTriples),
+			response: &cgschema.ConstructResponse{
+				Data:   cgschema.Binary(base64.StdEncoding.EncodeToString([]byte(graphNTriples))),
+				Format: cgschema.DataFormat_NTriples,
+			},
+			wantResult: graphNTriples,
+		},
+		{
+			name:       "construct as json-ld",
+			format:     dataverse.RDFFormatJSONLD,
+			wantFormat: toAddress(cgschema.DataFormat_NTriples),
+			response: &cgschema.ConstructResponse{
+				Data:   cgschema.Binary(base64.StdEncoding.EncodeToString([]byte(graphNTriples))),
+				Format: cgschema.DataFormat_NTriples,
+			},
+			wantResult: `[{"@id":"did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",` +
+				`"https://w3id.org/axone/ontology/v4/schema/credential/dataset/description/hasTitle":[{"@value":"title"}]}]`,
+		},
+		{
+			name:    "unsupported format",
+			format:  dataverse.RDFFormat("n_quads"),
+			wantErr: dataverse.NewDVError(dataverse.ErrUnsupportedFormat, fmt.Errorf("n_quads")),
+		},
+		{
+			name:          "grpc error",
+			format:        dataverse.RDFFormatNTriples,
+			wantFormat:    toAddress(cgschema.DataFormat_NTriples),
+			responseError: fmt.Errorf("gRPC: connection refused"),
+			wantErr:       fmt.Errorf("gRPC: connection refused"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a mocked cognitarium client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				mockCognitarium := testutil.NewMockCognitariumQueryClient(controller)
+				if test.wantFormat != nil {
+					mockCognitarium.
+						EXPECT().
+						Construct(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, req *cgschema.QueryMsg_Construct, _ ...any) (*cgschema.ConstructResponse, error) {
+							So(req.Format, ShouldResemble, test.wantFormat)
+							So(req.Query.Construct, ShouldHaveLength, 1)
+							So(string(*req.Query.Construct[0].Subject.Node.NamedNode.Full), ShouldEqual,
+								"did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5")
+							return test.response, test.responseError
+						}).
+						Times(1)
+				}
+
+				client := dataverse.NewDataverseQueryClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					mockCognitarium,
+					nil,
+				)
+
+				Convey("When ConstructResource is called", func() {
+					graph, err := client.ConstructResource(
+						context.Background(),
+						"did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+						test.format,
+					)
+
+					Convey("Then the serialized graph should be returned", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(string(graph), ShouldEqual, test.wantResult)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(graph, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package dataverse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+// brokenLawStoneError is the error a broken law-stone contract answers to any query.
+const brokenLawStoneError = "error(system_error(broken_law_stone),root)"
+
+// Program locates the Prolog program of a governance (law-stone contract) in the objectarium contract storing it.
+type Program struct {
+	// ObjectID is the identifier of the program object in the objectarium contract.
+	ObjectID string
+	// StorageAddr is the address of the objectarium contract storing the program.
+	StorageAddr string
+}
+
+func (c *queryClient) GovProgram(ctx context.Context, addr string) (*Program, error) {
+	gov, err := c.lawStoneFactory(addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create law-stone client: %w", err)
+	}
+
+	response, err := gov.Program(ctx, &lsschema.QueryMsg_Program{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query law-stone contract: %w", err)
+	}
+
+	return &Program{
+		ObjectID:    response.ObjectId,
+		StorageAddr: response.StorageAddress,
+	}, nil
+}
+
+func (c *queryClient) IsGovBroken(ctx context.Context, addr string) (bool, error) {
+	_, err := c.AskGov(ctx, addr, "true.")
+	var dvErr *DVError
+	if errors.As(err, &dvErr) && dvErr.message == ErrBrokenGov {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+func (t *txClient) BreakGov(ctx context.Context, addr string) (*types.TxResponse, error) {
+	return t.executeContract(ctx, addr, lsschema.ExecuteMsg{
+		BreakStone: &lsschema.ExecuteMsg_BreakStone{},
+	})
+}
+
+// isBroken tells if the given answer is the one of a broken law-stone contract.
+func isBroken(answer *lsschema.Answer) bool {
+	if answer == nil {
+		return false
+	}
+	for _, result := range answer.Results {
+		if result.Error != nil && *result.Error == brokenLawStoneError {
+			return true
+		}
+	}
+	return false
+}
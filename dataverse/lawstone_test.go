@@ -0,0 +1,238 @@
+package dataverse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	"github.com/axone-protocol/axone-sdk/tx"
+	"github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestClient_GovProgram(t *testing.T) {
+	tests := []struct {
+		name          string
+		addr          string
+		response      *lsschema.ProgramResponse
+		responseError error
+		wantErr       error
+		wantResult    *dataverse.Program
+	}{
+		{
+			name:    "law stone client new error",
+			addr:    "error",
+			wantErr: fmt.Errorf("failed to create law-stone client: error"),
+		},
+		{
+			name:          "law stone client program error",
+			addr:          "foo",
+			responseError: fmt.Errorf("error"),
+			wantErr:       fmt.Errorf("failed to query law-stone contract: error"),
+		},
+		{
+			name: "program location",
+			addr: "foo",
+			response: &lsschema.ProgramResponse{
+				ObjectId:       "4cbe36399aabfcc7158ee7a66cbfffa525bb0ceab33d1ff2cff08759fe0a9b05",
+				StorageAddress: "axone1objectarium",
+			},
+			wantResult: &dataverse.Program{
+				ObjectID:    "4cbe36399aabfcc7158ee7a66cbfffa525bb0ceab33d1ff2cff08759fe0a9b05",
+				StorageAddr: "axone1objectarium",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a mocked law-stone client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				lawStoneMock := testutil.NewMockLawStoneQueryClient(controller)
+				if test.addr != "error" {
+					lawStoneMock.EXPECT().
+						Program(gomock.Any(), &lsschema.QueryMsg_Program{}).
+						Return(test.response, test.responseError).
+						Times(1)
+				}
+
+				client := dataverse.NewDataverseQueryClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					testutil.NewMockCognitariumQueryClient(controller),
+					func(addr string) (lsschema.QueryClient, error) {
+						if addr == "error" {
+							return nil, fmt.Errorf("error")
+						}
+
+						return lawStoneMock, nil
+					},
+				)
+
+				Convey("When GovProgram is called", func() {
+					program, err := client.GovProgram(context.Background(), test.addr)
+
+					Convey("Then the location of the program should be returned", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(program, ShouldResemble, test.wantResult)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(program, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestClient_IsGovBroken(t *testing.T) {
+	tests := []struct {
+		name          string
+		response      *lsschema.AskResponse
+		responseError error
+		wantErr       error
+		wantResult    bool
+	}{
+		{
+			name:          "law stone client ask error",
+			responseError: fmt.Errorf("error"),
+			wantErr:       fmt.Errorf("failed to query law-stone contract: error"),
+		},
+		{
+			name: "active governance",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{{Substitutions: []lsschema.Substitution{}}},
+				},
+			},
+			wantResult: false,
+		},
+		{
+			name: "governance raising another error",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{{Error: toAddress("error(resource_error(gas),root)")}},
+				},
+			},
+			wantResult: false,
+		},
+		{
+			name: "broken governance",
+			response: &lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Results: []lsschema.Result{{Error: toAddress("error(system_error(broken_law_stone),root)")}},
+				},
+			},
+			wantResult: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a mocked law-stone client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				lawStoneMock := testutil.NewMockLawStoneQueryClient(controller)
+				lawStoneMock.EXPECT().
+					Ask(gomock.Any(), &lsschema.QueryMsg_Ask{Query: "true."}).
+					Return(test.response, test.responseError).
+					Times(1)
+
+				client := dataverse.NewDataverseQueryClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					testutil.NewMockCognitariumQueryClient(controller),
+					func(_ string) (lsschema.QueryClient, error) {
+						return lawStoneMock, nil
+					},
+				)
+
+				Convey("When IsGovBroken is called", func() {
+					broken, err := client.IsGovBroken(context.Background(), "foo")
+
+					Convey("Then it should tell if the governance is broken", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+						}
+						So(broken, ShouldEqual, test.wantResult)
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestClient_BreakGov(t *testing.T) {
+	tests := []struct {
+		name        string
+		sendTxError error
+		wantErr     error
+	}{
+		{
+			name: "governance broken",
+		},
+		{
+			name:        "transaction error",
+			sendTxError: fmt.Errorf("unauthorized"),
+			wantErr:     dataverse.NewDVError(dataverse.ErrSendTx, fmt.Errorf("unauthorized")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+				txConfig, err := tx.MakeDefaultTxConfig()
+				So(err, ShouldBeNil)
+
+				mockTxClient := testutil.NewMockTxClient(controller)
+				mockKeyring := testutil.NewMockKeyring(controller)
+
+				mockKeyring.EXPECT().Addr().Return("addr").AnyTimes()
+				mockTxClient.EXPECT().
+					SendTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, transaction tx.Transaction) (*types.TxResponse, error) {
+						So(transaction.Sender(), ShouldEqual, "addr")
+						if test.sendTxError != nil {
+							return nil, test.sendTxError
+						}
+						return &types.TxResponse{}, nil
+					}).
+					Times(1)
+
+				client := dataverse.NewDataverseTxClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					testutil.NewMockCognitariumQueryClient(controller),
+					nil,
+					mockTxClient,
+					txConfig,
+					mockKeyring,
+				)
+
+				Convey("When BreakGov is called", func() {
+					r, err := client.BreakGov(context.Background(), "axone1lawstone")
+
+					Convey("Then should return expected error", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(r, ShouldNotBeNil)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(r, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
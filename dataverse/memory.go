@@ -0,0 +1,335 @@
+package dataverse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+	dvschema "github.com/axone-protocol/axone-contract-schema/go/dataverse-schema/v6"
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axone-sdk/credential"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/piprate/json-gold/ld"
+	"google.golang.org/grpc"
+)
+
+const (
+	memoryDataverseAddr   = "memory-dataverse"
+	memoryCognitariumAddr = "memory-cognitarium"
+	memoryLawStonePrefix  = "memory-law-stone-"
+	rdfType               = "http://www.w3.org/1999/02/22-rdf-syntax-ns#type"
+)
+
+// MemoryDataverse is an in-memory dataverse, standing for the dataverse, cognitarium and law-stone contracts of a
+// chain so that the flows relying on a QueryClient, such as the storage and authentication ones, can be tested
+// realistically without any chain nor stubbed calls.
+//
+// Submitted credentials are recorded as triples the way the dataverse contract records them, i.e. their subject, types,
+// issuer and issuance date along with a claim node holding the properties of their subject, and the cognitarium
+// queries are evaluated over these triples. Signatures are not verified. Governances are answered by the Prolog
+// programs or Go policies registered at their address; governances deployed through DeployGovernance are registered
+// at a generated address.
+//
+// All the QueryClient methods are supported, the graphs being serialized in N-Triples whatever the Turtle or N-Triples
+// format asked; the RDF/XML format is not supported. Select queries are bounded by the default maximum query limit of
+// a cognitarium contract.
+type MemoryDataverse struct {
+	*queryClient
+
+	documentLoader ld.DocumentLoader
+	cognitarium    *memoryCognitarium
+
+	mu        sync.RWMutex
+	govs      map[string]lsschema.QueryClient
+	height    int64
+	blanks    int
+	lawStones int
+}
+
+var (
+	_ QueryClient = &MemoryDataverse{}
+	_ TxClient    = &MemoryDataverse{}
+)
+
+// MemoryOption is a function to configure a MemoryDataverse.
+type MemoryOption func(*MemoryDataverse)
+
+// WithDocumentLoader sets the document loader resolving the JSON-LD contexts of the submitted credentials. The
+// default one, fetching the contexts over the network, is used if not set.
+func WithDocumentLoader(documentLoader ld.DocumentLoader) MemoryOption {
+	return func(d *MemoryDataverse) {
+		d.documentLoader = documentLoader
+	}
+}
+
+// NewMemoryDataverse creates an empty MemoryDataverse, without any credential nor governance.
+func NewMemoryDataverse(opts ...MemoryOption) *MemoryDataverse {
+	d := &MemoryDataverse{
+		cognitarium: &memoryCognitarium{},
+		govs:        make(map[string]lsschema.QueryClient),
+	}
+	d.queryClient = &queryClient{
+		dataverseContractAddr:   memoryDataverseAddr,
+		cognitariumContractAddr: memoryCognitariumAddr,
+		dataverseClient:         memoryDataverseContract{},
+		cognitariumClient:       d.cognitarium,
+		lawStoneFactory:         d.lawStone,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// RegisterGov registers at the given address a governance answering from the given Prolog program, evaluated by a
+// GovSimulator configured with the given options.
+func (d *MemoryDataverse) RegisterGov(ctx context.Context, addr, program string, opts ...SimulatorOption) error {
+	simulator, err := NewGovSimulator(ctx, program, opts...)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.govs[addr] = simulator.LawStone()
+
+	return nil
+}
+
+// RegisterGovPolicy registers at the given address a governance answering from the given Go policy. The policy is
+// asked about each of the given actions to answer the permitted actions of an identity.
+func (d *MemoryDataverse) RegisterGovPolicy(addr string, policy GovPolicy, actions ...string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.govs[addr] = &policyLawStone{policy: policy, actions: actions}
+}
+
+// SubmitClaims records the claims of a verifiable credential, checked against the ClaimShapes if asked as
+// TxClient.SubmitClaims does. As the dataverse contract, it rejects a credential without identifier or already submitted.
+func (d *MemoryDataverse) SubmitClaims(
+	ctx context.Context,
+	vc *verifiable.Credential,
+	opts ...SubmitOption,
+) (*types.TxResponse, error) {
+	return d.submitClaims(ctx, vc, d.documentLoader, opts...)
+}
+
+func (d *MemoryDataverse) submitClaims(
+	_ context.Context,
+	vc *verifiable.Credential,
+	documentLoader ld.DocumentLoader,
+	opts ...SubmitOption,
+) (*types.TxResponse, error) {
+	options := &submitOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	claims, err := submittedClaims(vc, documentLoader, options)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	credentialID, quads, err := d.credentialTriples(claims)
+	if err != nil {
+		return nil, NewDVError(ErrTxFailed, err)
+	}
+	if d.cognitarium.has(credentialID) {
+		return nil, NewDVError(ErrTxFailed, fmt.Errorf("credential %s already submitted", credentialID))
+	}
+
+	options.setHash(claims)
+	if options.simulate {
+		return &types.TxResponse{}, nil
+	}
+
+	d.cognitarium.insert(credentialID, quads)
+	return d.newTxResponse(), nil
+}
+
+// RevokeClaims removes the triples recorded for the credential of the given identifier.
+func (d *MemoryDataverse) RevokeClaims(_ context.Context, credentialID string) (*types.TxResponse, error) {
+	if credentialID == "" {
+		return nil, NewDVError(ErrNoIdentifier, nil)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.cognitarium.remove(credentialID) {
+		return nil, NewDVError(ErrTxFailed, fmt.Errorf("credential %s not found", credentialID))
+	}
+	return d.newTxResponse(), nil
+}
+
+// BreakGov breaks the governance registered at the given address, which then answers any query as a broken law-stone
+// contract does.
+func (d *MemoryDataverse) BreakGov(_ context.Context, addr string) (*types.TxResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	gov, ok := d.govs[addr]
+	if !ok {
+		return nil, NewDVError(ErrTxFailed, fmt.Errorf("no governance at address %s", addr))
+	}
+	if _, broken := gov.(*brokenLawStone); !broken {
+		d.govs[addr] = &brokenLawStone{gov}
+	}
+	return d.newTxResponse(), nil
+}
+
+// DeployGovernance registers the Prolog program of the given spec at a generated address, then issues and submits the
+// credential linking the resource to it. The credential is not signed.
+func (d *MemoryDataverse) DeployGovernance(
+	ctx context.Context,
+	spec GovernanceSpec,
+	descriptor GovernanceDescriptorFunc,
+	documentLoader ld.DocumentLoader,
+) (*GovernanceDeployment, error) {
+	d.mu.Lock()
+	d.lawStones++
+	addr := fmt.Sprintf("%s%d", memoryLawStonePrefix, d.lawStones)
+	instantiateTx := d.newTxResponse()
+	d.mu.Unlock()
+
+	if err := d.RegisterGov(ctx, addr, spec.Program); err != nil {
+		return nil, err
+	}
+	deployment := &GovernanceDeployment{GovAddr: addr, InstantiateTx: instantiateTx}
+
+	var err error
+	deployment.Credential, err = credential.New(
+		descriptor(addr),
+		credential.WithParser(credential.NewDefaultParser(documentLoader)),
+	).Generate()
+	if err != nil {
+		return deployment, NewDVError(ErrIssueCredential, err)
+	}
+
+	deployment.SubmitTx, err = d.submitClaims(ctx, deployment.Credential, documentLoader)
+	if err != nil {
+		return deployment, err
+	}
+
+	return deployment, nil
+}
+
+func (d *MemoryDataverse) lawStone(addr string) (lsschema.QueryClient, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	gov, ok := d.govs[addr]
+	if !ok {
+		return nil, fmt.Errorf("no governance at address %s", addr)
+	}
+	return gov, nil
+}
+
+// newTxResponse returns the response of a transaction included in a new block.
+func (d *MemoryDataverse) newTxResponse() *types.TxResponse {
+	d.height++
+	return &types.TxResponse{Height: d.height}
+}
+
+// credentialTriples returns the identifier of the credential of the given claims along with the triples the
+// dataverse contract records for it. The blank nodes of the claims are given fresh labels, so that they are not
+// shared between credentials.
+//
+//nolint:cyclop
+func (d *MemoryDataverse) credentialTriples(claims []byte) (string, []*ld.Quad, error) {
+	dataset, err := ld.ParseNQuads(string(claims))
+	if err != nil {
+		return "", nil, err
+	}
+	quads := dataset.Graphs[defaultGraph]
+
+	var credentialNode ld.Node
+	for _, quad := range quads {
+		if quad.Predicate.GetValue() == rdfType && quad.Object.GetValue() == VerifiableCredentialType {
+			credentialNode = quad.Subject
+			break
+		}
+	}
+	if credentialNode == nil || !ld.IsIRI(credentialNode) {
+		return "", nil, errors.New("expected a verifiable credential with an identifier")
+	}
+
+	var subject ld.Node
+	var triples []*ld.Quad
+	body := func(predicate cgschema.IRI_Full, object ld.Node) {
+		triples = append(triples, ld.NewQuad(credentialNode, ld.NewIRI(string(predicate)), object, defaultGraph))
+	}
+	for _, quad := range quads {
+		if !quad.Subject.Equal(credentialNode) {
+			continue
+		}
+		switch quad.Predicate.GetValue() {
+		case rdfType:
+			body(VcBodyType, quad.Object)
+		case credentialsNamespace + "issuer":
+			body(VcBodyIssuer, quad.Object)
+		case credentialsNamespace + "issuanceDate":
+			body(VcBodyValidFrom, quad.Object)
+		case credentialsNamespace + "credentialSubject":
+			subject = quad.Object
+			body(VcBodySubject, quad.Object)
+		}
+	}
+	if subject == nil || !ld.IsIRI(subject) {
+		return "", nil, errors.New("expected a credential subject with an identifier")
+	}
+
+	labels := make(map[string]ld.Node)
+	rename := func(node ld.Node) ld.Node {
+		if !ld.IsBlankNode(node) {
+			return node
+		}
+		if renamed, ok := labels[node.GetValue()]; ok {
+			return renamed
+		}
+		d.blanks++
+		renamed := ld.NewBlankNode(fmt.Sprintf("_:b%d", d.blanks))
+		labels[node.GetValue()] = renamed
+		return renamed
+	}
+
+	claimNode := rename(ld.NewBlankNode("_:claim"))
+	body(VcBodyClaim, claimNode)
+	for described := []ld.Node{subject}; len(described) > 0; described = described[1:] {
+		node := claimNode
+		if described[0] != subject {
+			node = rename(described[0])
+		}
+		for _, quad := range quads {
+			if !quad.Subject.Equal(described[0]) {
+				continue
+			}
+			if _, seen := labels[quad.Object.GetValue()]; ld.IsBlankNode(quad.Object) && !seen {
+				described = append(described, quad.Object)
+			}
+			triples = append(triples, ld.NewQuad(node, quad.Predicate, rename(quad.Object), defaultGraph))
+		}
+	}
+
+	return credentialNode.GetValue(), triples, nil
+}
+
+var _ dvschema.QueryClient = memoryDataverseContract{}
+
+// memoryDataverseContract is a dataverse contract client describing the MemoryDataverse.
+type memoryDataverseContract struct{}
+
+func (memoryDataverseContract) Dataverse(
+	_ context.Context,
+	_ *dvschema.QueryMsg_Dataverse,
+	_ ...grpc.CallOption,
+) (*dvschema.DataverseResponse, error) {
+	return &dvschema.DataverseResponse{Name: "memory", TriplestoreAddress: memoryCognitariumAddr}, nil
+}
@@ -0,0 +1,636 @@
+package dataverse
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+	"github.com/piprate/json-gold/ld"
+	"google.golang.org/grpc"
+)
+
+const (
+	// memoryMaxQueryLimit is the maximum number of results a select query can return from the in-memory cognitarium,
+	// the default limit of a cognitarium contract.
+	memoryMaxQueryLimit = 30
+	defaultGraph        = "@default"
+	xsdNamespace        = "http://www.w3.org/2001/XMLSchema#"
+)
+
+var _ cgschema.QueryClient = &memoryCognitarium{}
+
+// memoryCognitarium is a cognitarium contract client evaluating queries over triples kept in memory.
+type memoryCognitarium struct {
+	mu      sync.RWMutex
+	triples []memoryTriple
+}
+
+// memoryTriple is a stored triple along with the identifier of the credential it has been recorded for.
+type memoryTriple struct {
+	*ld.Quad
+	owner string
+}
+
+// solution binds the variables of a query to the nodes of a matching triple.
+type solution map[string]ld.Node
+
+func (c *memoryCognitarium) insert(owner string, quads []*ld.Quad) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, quad := range quads {
+		c.triples = append(c.triples, memoryTriple{Quad: quad, owner: owner})
+	}
+}
+
+// remove removes all the triples recorded for the given credential, returning false if there is none.
+func (c *memoryCognitarium) remove(owner string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	triples := c.triples[:0]
+	for _, triple := range c.triples {
+		if triple.owner != owner {
+			triples = append(triples, triple)
+		}
+	}
+	removed := len(triples) != len(c.triples)
+	clear(c.triples[len(triples):])
+	c.triples = triples
+	return removed
+}
+
+func (c *memoryCognitarium) has(owner string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, triple := range c.triples {
+		if triple.owner == owner {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *memoryCognitarium) Store(
+	_ context.Context,
+	_ *cgschema.QueryMsg_Store,
+	_ ...grpc.CallOption,
+) (*cgschema.StoreResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return &cgschema.StoreResponse{
+		Limits: cgschema.StoreLimits{
+			MaxQueryLimit:         memoryMaxQueryLimit,
+			MaxQueryVariableCount: memoryMaxQueryLimit,
+		},
+		Stat: cgschema.StoreStat{TripleCount: cgschema.Uint128(strconv.Itoa(len(c.triples)))},
+	}, nil
+}
+
+func (c *memoryCognitarium) Select(
+	_ context.Context,
+	req *cgschema.QueryMsg_Select,
+	_ ...grpc.CallOption,
+) (*cgschema.SelectResponse, error) {
+	limit := memoryMaxQueryLimit
+	if req.Query.Limit != nil {
+		if *req.Query.Limit > memoryMaxQueryLimit {
+			return nil, fmt.Errorf("query limit %d exceeds the maximum of %d", *req.Query.Limit, memoryMaxQueryLimit)
+		}
+		limit = *req.Query.Limit
+	}
+
+	solutions, err := c.evaluate(req.Query.Prefixes, req.Query.Where)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make([]string, 0, len(req.Query.Select))
+	for _, item := range req.Query.Select {
+		if item.Variable == nil {
+			return nil, errors.New("only variables can be selected")
+		}
+		vars = append(vars, string(*item.Variable))
+	}
+
+	bindings := make([]map[string]cgschema.Value, 0, min(limit, len(solutions)))
+	for _, s := range solutions[:min(limit, len(solutions))] {
+		binding := make(map[string]cgschema.Value, len(vars))
+		for _, v := range vars {
+			if node, ok := s[v]; ok {
+				binding[v] = nodeValue(node)
+			}
+		}
+		bindings = append(bindings, binding)
+	}
+
+	return &cgschema.SelectResponse{
+		Head:    cgschema.Head{Vars: vars},
+		Results: cgschema.Results{Bindings: bindings},
+	}, nil
+}
+
+// Construct builds the triples of the templates for each solution of the where clause, the templates defaulting to
+// the patterns of the where clause if it is a single basic graph pattern.
+func (c *memoryCognitarium) Construct(
+	_ context.Context,
+	req *cgschema.QueryMsg_Construct,
+	_ ...grpc.CallOption,
+) (*cgschema.ConstructResponse, error) {
+	format, err := memoryFormat(req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	solutions, err := c.evaluate(req.Query.Prefixes, req.Query.Where)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := req.Query.Construct
+	if len(templates) == 0 && req.Query.Where.Bgp != nil {
+		for _, p := range req.Query.Where.Bgp.Patterns {
+			templates = append(templates, cgschema.TripleConstructTemplate(p))
+		}
+	}
+
+	var quads []*ld.Quad
+	for _, s := range solutions {
+		for _, template := range templates {
+			pattern, err := newMemoryPattern(req.Query.Prefixes, cgschema.TriplePattern(template))
+			if err != nil {
+				return nil, err
+			}
+			if quad, ok := pattern.instantiate(s); ok {
+				quads = appendQuad(quads, quad)
+			}
+		}
+	}
+
+	data, err := serializeQuads(quads)
+	if err != nil {
+		return nil, err
+	}
+	return &cgschema.ConstructResponse{Data: data, Format: format}, nil
+}
+
+// Describe returns the triples having the described resource as subject, along with the ones describing the blank
+// nodes they refer to.
+func (c *memoryCognitarium) Describe(
+	_ context.Context,
+	req *cgschema.QueryMsg_Describe,
+	_ ...grpc.CallOption,
+) (*cgschema.DescribeResponse, error) {
+	format, err := memoryFormat(req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []ld.Node
+	switch {
+	case req.Query.Resource.NamedNode != nil:
+		iri, err := expandIRI(req.Query.Prefixes, cgschema.IRI(*req.Query.Resource.NamedNode))
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, ld.NewIRI(iri))
+	case req.Query.Resource.Variable != nil && req.Query.Where != nil:
+		solutions, err := c.evaluate(req.Query.Prefixes, *req.Query.Where)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range solutions {
+			if node, ok := s[string(*req.Query.Resource.Variable)]; ok {
+				resources = append(resources, node)
+			}
+		}
+	default:
+		return nil, errors.New("expected a resource to describe")
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var quads []*ld.Quad
+	for len(resources) > 0 {
+		resource := resources[0]
+		resources = resources[1:]
+		for _, triple := range c.triples {
+			if !triple.Subject.Equal(resource) || containsQuad(quads, triple.Quad) {
+				continue
+			}
+			quads = append(quads, triple.Quad)
+			if ld.IsBlankNode(triple.Object) {
+				resources = append(resources, triple.Object)
+			}
+		}
+	}
+
+	data, err := serializeQuads(quads)
+	if err != nil {
+		return nil, err
+	}
+	return &cgschema.DescribeResponse{Data: data, Format: format}, nil
+}
+
+// evaluate returns the solutions of the given where clause over the stored triples.
+func (c *memoryCognitarium) evaluate(prefixes []cgschema.Prefix, where cgschema.WhereClause) ([]solution, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.evaluateFrom(prefixes, where, []solution{{}})
+}
+
+// evaluateFrom extends each of the given solutions with the solutions of the given where clause.
+func (c *memoryCognitarium) evaluateFrom(
+	prefixes []cgschema.Prefix,
+	where cgschema.WhereClause,
+	solutions []solution,
+) ([]solution, error) {
+	switch {
+	case where.Bgp != nil:
+		for _, p := range where.Bgp.Patterns {
+			pattern, err := newMemoryPattern(prefixes, p)
+			if err != nil {
+				return nil, err
+			}
+			solutions = c.match(pattern, solutions)
+		}
+		return solutions, nil
+	case where.LateralJoin != nil:
+		left, err := c.evaluateFrom(prefixes, where.LateralJoin.Left, solutions)
+		if err != nil {
+			return nil, err
+		}
+		return c.evaluateFrom(prefixes, where.LateralJoin.Right, left)
+	case where.Filter != nil:
+		inner, err := c.evaluateFrom(prefixes, where.Filter.Inner, solutions)
+		if err != nil {
+			return nil, err
+		}
+		filtered := make([]solution, 0, len(inner))
+		for _, s := range inner {
+			ok, err := evaluateCondition(prefixes, where.Filter.Expr, s)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				filtered = append(filtered, s)
+			}
+		}
+		return filtered, nil
+	default:
+		return solutions, nil
+	}
+}
+
+func (c *memoryCognitarium) match(pattern memoryPattern, solutions []solution) []solution {
+	var matched []solution
+	for _, s := range solutions {
+		for _, triple := range c.triples {
+			if extended, ok := pattern.match(triple.Quad, s); ok {
+				matched = append(matched, extended)
+			}
+		}
+	}
+	return matched
+}
+
+// memoryTerm is a term of a triple pattern, either a variable or a node.
+type memoryTerm struct {
+	variable string
+	node     ld.Node
+}
+
+type memoryPattern struct {
+	subject, predicate, object memoryTerm
+}
+
+//nolint:cyclop
+func newMemoryPattern(prefixes []cgschema.Prefix, p cgschema.TriplePattern) (memoryPattern, error) {
+	var pattern memoryPattern
+	var err error
+
+	switch {
+	case p.Subject.Variable != nil:
+		pattern.subject.variable = string(*p.Subject.Variable)
+	case p.Subject.Node != nil:
+		if pattern.subject, err = nodeTerm(prefixes, cgschema.Node(*p.Subject.Node)); err != nil {
+			return pattern, err
+		}
+	default:
+		return pattern, errors.New("expected a subject")
+	}
+
+	switch {
+	case p.Predicate.Variable != nil:
+		pattern.predicate.variable = string(*p.Predicate.Variable)
+	case p.Predicate.NamedNode != nil:
+		iri, err := expandIRI(prefixes, cgschema.IRI(*p.Predicate.NamedNode))
+		if err != nil {
+			return pattern, err
+		}
+		pattern.predicate.node = ld.NewIRI(iri)
+	default:
+		return pattern, errors.New("expected a predicate")
+	}
+
+	switch {
+	case p.Object.Variable != nil:
+		pattern.object.variable = string(*p.Object.Variable)
+	case p.Object.Node != nil:
+		if pattern.object, err = nodeTerm(prefixes, cgschema.Node(*p.Object.Node)); err != nil {
+			return pattern, err
+		}
+	case p.Object.Literal != nil:
+		if pattern.object.node, err = literalNode(prefixes, cgschema.Literal(*p.Object.Literal)); err != nil {
+			return pattern, err
+		}
+	default:
+		return pattern, errors.New("expected an object")
+	}
+
+	return pattern, nil
+}
+
+// match returns the given solution extended with the bindings making the pattern match the triple, if any.
+func (p memoryPattern) match(quad *ld.Quad, s solution) (solution, bool) {
+	extended := s
+	for _, pair := range []struct {
+		term memoryTerm
+		node ld.Node
+	}{{p.subject, quad.Subject}, {p.predicate, quad.Predicate}, {p.object, quad.Object}} {
+		if pair.term.variable == "" {
+			if !pair.term.node.Equal(pair.node) {
+				return nil, false
+			}
+			continue
+		}
+		if bound, ok := extended[pair.term.variable]; ok {
+			if !bound.Equal(pair.node) {
+				return nil, false
+			}
+			continue
+		}
+		if len(extended) == len(s) {
+			extended = make(solution, len(s)+1)
+			for k, v := range s {
+				extended[k] = v
+			}
+		}
+		extended[pair.term.variable] = pair.node
+	}
+	return extended, true
+}
+
+// instantiate returns the triple of the pattern with its variables replaced by their bindings, if all bound.
+func (p memoryPattern) instantiate(s solution) (*ld.Quad, bool) {
+	nodes := make([]ld.Node, 0, 3)
+	for _, term := range []memoryTerm{p.subject, p.predicate, p.object} {
+		node := term.node
+		if term.variable != "" {
+			var ok bool
+			if node, ok = s[term.variable]; !ok {
+				return nil, false
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	return ld.NewQuad(nodes[0], nodes[1], nodes[2], defaultGraph), true
+}
+
+// nodeTerm returns the term of a node of a pattern, blank nodes acting as variables as in a cognitarium query.
+func nodeTerm(prefixes []cgschema.Prefix, node cgschema.Node) (memoryTerm, error) {
+	if node.BlankNode != nil {
+		return memoryTerm{variable: "_:" + string(*node.BlankNode)}, nil
+	}
+	if node.NamedNode == nil {
+		return memoryTerm{}, errors.New("expected a named node or a blank node")
+	}
+	iri, err := expandIRI(prefixes, cgschema.IRI(*node.NamedNode))
+	if err != nil {
+		return memoryTerm{}, err
+	}
+	return memoryTerm{node: ld.NewIRI(iri)}, nil
+}
+
+func expandIRI(prefixes []cgschema.Prefix, iri cgschema.IRI) (string, error) {
+	if iri.Full != nil {
+		return string(*iri.Full), nil
+	}
+	if iri.Prefixed == nil {
+		return "", errors.New("expected an IRI")
+	}
+
+	prefix, local, ok := strings.Cut(string(*iri.Prefixed), ":")
+	if !ok {
+		return "", fmt.Errorf("malformed prefixed IRI %s", *iri.Prefixed)
+	}
+	for _, p := range prefixes {
+		if p.Prefix == prefix {
+			return p.Namespace + local, nil
+		}
+	}
+	return "", fmt.Errorf("undefined prefix %s", prefix)
+}
+
+func literalNode(prefixes []cgschema.Prefix, literal cgschema.Literal) (ld.Node, error) {
+	switch {
+	case literal.Simple != nil:
+		return ld.NewLiteral(string(*literal.Simple), ld.XSDString, ""), nil
+	case literal.LanguageTaggedString != nil:
+		return ld.NewLiteral(literal.LanguageTaggedString.Value, ld.RDFLangString, literal.LanguageTaggedString.Language), nil
+	case literal.TypedValue != nil:
+		datatype, err := expandIRI(prefixes, literal.TypedValue.Datatype)
+		if err != nil {
+			return nil, err
+		}
+		return ld.NewLiteral(literal.TypedValue.Value, datatype, ""), nil
+	default:
+		return nil, errors.New("expected a literal")
+	}
+}
+
+// nodeValue returns the value of a node as bound in the results of a select query.
+func nodeValue(node ld.Node) cgschema.Value {
+	switch n := node.(type) {
+	case *ld.Literal:
+		value := cgschema.Value_Literal{Type: "literal", Value: n.Value}
+		switch {
+		case n.Language != "":
+			value.Lang = ref(n.Language)
+		case n.Datatype != "" && n.Datatype != ld.XSDString:
+			value.Datatype = &cgschema.IRI{Full: ref(cgschema.IRI_Full(n.Datatype))}
+		}
+		return cgschema.Value{ValueType: value}
+	case *ld.BlankNode:
+		return cgschema.Value{ValueType: cgschema.BlankNode{Type: "blank_node", Value: strings.TrimPrefix(n.Attribute, "_:")}}
+	default:
+		return cgschema.Value{ValueType: cgschema.URI{Type: "uri", Value: cgschema.IRI{Full: ref(cgschema.IRI_Full(node.GetValue()))}}}
+	}
+}
+
+// evaluateCondition tells if the given filter expression holds for the solution.
+func evaluateCondition(prefixes []cgschema.Prefix, expr cgschema.Expression, s solution) (bool, error) {
+	switch {
+	case expr.And != nil:
+		for _, e := range *expr.And {
+			if ok, err := evaluateCondition(prefixes, e, s); err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	case expr.Or != nil:
+		for _, e := range *expr.Or {
+			if ok, err := evaluateCondition(prefixes, e, s); err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	case expr.Not != nil:
+		ok, err := evaluateCondition(prefixes, cgschema.Expression(*expr.Not), s)
+		return !ok, err
+	}
+
+	operands, holds := comparisonOf(expr)
+	if operands == nil {
+		return false, errors.New("expected a boolean expression")
+	}
+	left, err := evaluateTerm(prefixes, operands.F0, s)
+	if err != nil {
+		return false, err
+	}
+	right, err := evaluateTerm(prefixes, operands.F1, s)
+	if err != nil {
+		return false, err
+	}
+	return holds(compareNodes(left, right)), nil
+}
+
+// comparisonOf returns the operands of a comparison expression along with the test of their ordering, nil if the
+// expression is not a comparison.
+func comparisonOf(expr cgschema.Expression) (*cgschema.Tuple_of_Expression_and_Expression, func(int) bool) {
+	switch {
+	case expr.Equal != nil:
+		return (*cgschema.Tuple_of_Expression_and_Expression)(expr.Equal), func(c int) bool { return c == 0 }
+	case expr.Greater != nil:
+		return (*cgschema.Tuple_of_Expression_and_Expression)(expr.Greater), func(c int) bool { return c > 0 }
+	case expr.GreaterOrEqual != nil:
+		return (*cgschema.Tuple_of_Expression_and_Expression)(expr.GreaterOrEqual), func(c int) bool { return c >= 0 }
+	case expr.Less != nil:
+		return (*cgschema.Tuple_of_Expression_and_Expression)(expr.Less), func(c int) bool { return c < 0 }
+	case expr.LessOrEqual != nil:
+		return (*cgschema.Tuple_of_Expression_and_Expression)(expr.LessOrEqual), func(c int) bool { return c <= 0 }
+	default:
+		return nil, nil
+	}
+}
+
+// compareNodes orders two nodes, numeric literals being compared by value and other nodes by their lexical form.
+// Only equal nodes compare to 0.
+func compareNodes(left, right ld.Node) int {
+	if l, ok := numericValue(left); ok {
+		if r, ok := numericValue(right); ok {
+			return cmp.Compare(l, r)
+		}
+	}
+	if c := strings.Compare(left.GetValue(), right.GetValue()); c != 0 {
+		return c
+	}
+	return strings.Compare(nodeKind(left), nodeKind(right))
+}
+
+// nodeKind distinguishes the nodes sharing the same lexical form.
+func nodeKind(node ld.Node) string {
+	if literal, ok := node.(*ld.Literal); ok {
+		return "literal^^" + literal.Datatype + "@" + literal.Language
+	}
+	return fmt.Sprintf("%T", node)
+}
+
+func evaluateTerm(prefixes []cgschema.Prefix, expr cgschema.Expression, s solution) (ld.Node, error) {
+	switch {
+	case expr.Variable != nil:
+		node, ok := s[string(*expr.Variable)]
+		if !ok {
+			return nil, fmt.Errorf("unbound variable %s", *expr.Variable)
+		}
+		return node, nil
+	case expr.NamedNode != nil:
+		iri, err := expandIRI(prefixes, cgschema.IRI(*expr.NamedNode))
+		if err != nil {
+			return nil, err
+		}
+		return ld.NewIRI(iri), nil
+	case expr.Literal != nil:
+		return literalNode(prefixes, cgschema.Literal(*expr.Literal))
+	default:
+		return nil, errors.New("expected a term expression")
+	}
+}
+
+func numericValue(node ld.Node) (float64, bool) {
+	literal, ok := node.(*ld.Literal)
+	if !ok || !strings.HasPrefix(literal.Datatype, xsdNamespace) {
+		return 0, false
+	}
+	switch strings.TrimPrefix(literal.Datatype, xsdNamespace) {
+	case "integer", "decimal", "double", "float", "long", "int", "short", "byte",
+		"nonNegativeInteger", "positiveInteger", "nonPositiveInteger", "negativeInteger",
+		"unsignedLong", "unsignedInt", "unsignedShort", "unsignedByte":
+		v, err := strconv.ParseFloat(literal.Value, 64)
+		return v, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// memoryFormat returns the format in which the in-memory cognitarium serializes graphs, N-Triples being a subset of
+// Turtle and N-Quads.
+func memoryFormat(format *cgschema.DataFormat) (cgschema.DataFormat, error) {
+	if format == nil {
+		return cgschema.DataFormat_Turtle, nil
+	}
+	switch *format {
+	case cgschema.DataFormat_NTriples, cgschema.DataFormat_Turtle, cgschema.DataFormat_NQuads:
+		return *format, nil
+	default:
+		return "", NewDVError(ErrUnsupportedFormat, fmt.Errorf("%s", *format))
+	}
+}
+
+func serializeQuads(quads []*ld.Quad) (cgschema.Binary, error) {
+	dataset := ld.NewRDFDataset()
+	dataset.Graphs[defaultGraph] = quads
+
+	serialized, err := (&ld.NQuadRDFSerializer{}).Serialize(dataset)
+	if err != nil {
+		return "", err
+	}
+	nquads, _ := serialized.(string)
+	return cgschema.Binary(base64.StdEncoding.EncodeToString([]byte(nquads))), nil
+}
+
+func appendQuad(quads []*ld.Quad, quad *ld.Quad) []*ld.Quad {
+	if containsQuad(quads, quad) {
+		return quads
+	}
+	return append(quads, quad)
+}
+
+func containsQuad(quads []*ld.Quad, quad *ld.Quad) bool {
+	for _, q := range quads {
+		if q.Equal(quad) {
+			return true
+		}
+	}
+	return false
+}
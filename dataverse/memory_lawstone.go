@@ -0,0 +1,271 @@
+package dataverse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axone-sdk/prolog"
+	"google.golang.org/grpc"
+)
+
+// GovPolicy decides, in place of a governance program, whether the identity of the given DID is permitted to perform
+// an action. It returns the result the tell/4 predicate would give, e.g. PermittedResult, and the evidence justifying
+// it, if any. A nil result means the governance gives no result; an error is reported as raised by the governance.
+type GovPolicy func(ctx context.Context, did, action string) (result, evidence prolog.Term, err error)
+
+var _ lsschema.QueryClient = &policyLawStone{}
+
+// policyLawStone is a law-stone contract client answering the governance queries of a QueryClient from a GovPolicy.
+// It understands the conjunctions of the tell/4, tell_permitted_actions/2 and findall/3 over tell/4 goals, the other
+// goals raising an existence error.
+type policyLawStone struct {
+	policy GovPolicy
+	// actions are the ones the policy is asked about to answer tell_permitted_actions/2.
+	actions []string
+}
+
+func (l *policyLawStone) Ask(
+	ctx context.Context,
+	req *lsschema.QueryMsg_Ask,
+	_ ...grpc.CallOption,
+) (*lsschema.AskResponse, error) {
+	query, err := prolog.Parse(req.Query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse query: %w", err)
+	}
+
+	answer := &lsschema.Answer{Variables: queryVariables(query, nil), Results: []lsschema.Result{}}
+	bindings := make(map[prolog.Variable]prolog.Term)
+	for _, goal := range conjunction(query) {
+		ok, err := l.solve(ctx, goal, bindings)
+		if err != nil {
+			answer.Results = append(answer.Results, lsschema.Result{Error: ref(err.Error()), Substitutions: []lsschema.Substitution{}})
+			return &lsschema.AskResponse{Answer: answer}, nil
+		}
+		if !ok {
+			return &lsschema.AskResponse{Answer: answer}, nil
+		}
+	}
+
+	substitutions := make([]lsschema.Substitution, 0, len(bindings))
+	for _, v := range answer.Variables {
+		if term, ok := bindings[prolog.Variable(v)]; ok {
+			substitutions = append(substitutions, lsschema.Substitution{Variable: v, Expression: term.String()})
+		}
+	}
+	answer.Results = append(answer.Results, lsschema.Result{Substitutions: substitutions})
+
+	return &lsschema.AskResponse{Answer: answer}, nil
+}
+
+// Program returns an empty program location, the governance having no program.
+func (l *policyLawStone) Program(
+	_ context.Context,
+	_ *lsschema.QueryMsg_Program,
+	_ ...grpc.CallOption,
+) (*lsschema.ProgramResponse, error) {
+	return &lsschema.ProgramResponse{}, nil
+}
+
+// ProgramCode returns no code, the governance having no program.
+func (l *policyLawStone) ProgramCode(
+	_ context.Context,
+	_ *lsschema.QueryMsg_ProgramCode,
+	_ ...grpc.CallOption,
+) (*string, error) {
+	return nil, nil //nolint:nilnil
+}
+
+// solve solves a goal of the query, binding its variables. It returns false if the goal has no solution.
+func (l *policyLawStone) solve(ctx context.Context, goal prolog.Term, bindings map[prolog.Variable]prolog.Term) (bool, error) {
+	if goal == prolog.Atom("true") {
+		return true, nil
+	}
+
+	compound, ok := goal.(prolog.Compound)
+	switch {
+	case ok && compound.Functor == "tell" && len(compound.Args) == 4:
+		result, evidence, err := l.decide(ctx, compound)
+		if err != nil || result == nil {
+			return false, err
+		}
+		return unify(compound.Args[2], result, bindings) && unify(compound.Args[3], evidence, bindings), nil
+	case ok && compound.Functor == "findall" && len(compound.Args) == 3:
+		tell, isTell := compound.Args[1].(prolog.Compound)
+		if !isTell || tell.Functor != "tell" || len(tell.Args) != 4 {
+			return false, existenceError(compound.Args[1])
+		}
+		result, evidence, err := l.decide(ctx, tell)
+		if err != nil {
+			return false, err
+		}
+		solutions := prolog.List{Elements: []prolog.Term{}}
+		if result != nil {
+			local := map[prolog.Variable]prolog.Term{}
+			if unify(tell.Args[2], result, local) && unify(tell.Args[3], evidence, local) {
+				solutions.Elements = append(solutions.Elements, substitute(compound.Args[0], local))
+			}
+		}
+		return unify(compound.Args[2], solutions, bindings), nil
+	case ok && compound.Functor == "tell_permitted_actions" && len(compound.Args) == 2:
+		did, err := atomArg(compound.Args[0])
+		if err != nil {
+			return false, err
+		}
+		permitted := prolog.List{Elements: []prolog.Term{}}
+		for _, action := range l.actions {
+			result, _, err := l.policy(ctx, did, action)
+			if err != nil {
+				return false, err
+			}
+			if result == PermittedResult {
+				permitted.Elements = append(permitted.Elements, prolog.Atom(action))
+			}
+		}
+		return unify(compound.Args[1], permitted, bindings), nil
+	default:
+		return false, existenceError(goal)
+	}
+}
+
+// decide calls the policy with the DID and action of a tell/4 goal.
+func (l *policyLawStone) decide(ctx context.Context, tell prolog.Compound) (prolog.Term, prolog.Term, error) {
+	did, err := atomArg(tell.Args[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	action, err := atomArg(tell.Args[1])
+	if err != nil {
+		return nil, nil, err
+	}
+	return l.policy(ctx, did, action)
+}
+
+var _ lsschema.QueryClient = &brokenLawStone{}
+
+// brokenLawStone is a law-stone contract client answering as a broken law-stone contract does, while still giving
+// the location and code of its program.
+type brokenLawStone struct {
+	lsschema.QueryClient
+}
+
+func (l *brokenLawStone) Ask(
+	_ context.Context,
+	req *lsschema.QueryMsg_Ask,
+	_ ...grpc.CallOption,
+) (*lsschema.AskResponse, error) {
+	query, err := prolog.Parse(req.Query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse query: %w", err)
+	}
+
+	return &lsschema.AskResponse{
+		Answer: &lsschema.Answer{
+			Variables: queryVariables(query, nil),
+			Results:   []lsschema.Result{{Error: ref(brokenLawStoneError), Substitutions: []lsschema.Substitution{}}},
+		},
+	}, nil
+}
+
+// conjunction returns the goals of a conjunction.
+func conjunction(goal prolog.Term) []prolog.Term {
+	if c, ok := goal.(prolog.Compound); ok && c.Functor == "," && len(c.Args) == 2 {
+		return append(conjunction(c.Args[0]), conjunction(c.Args[1])...)
+	}
+	return []prolog.Term{goal}
+}
+
+// queryVariables appends to the given names the ones of the variables of a term not already present, in their order
+// of appearance. Anonymous variables are left out.
+func queryVariables(t prolog.Term, names []string) []string {
+	switch v := t.(type) {
+	case prolog.Variable:
+		for _, name := range names {
+			if name == string(v) {
+				return names
+			}
+		}
+		if v != "_" {
+			names = append(names, string(v))
+		}
+	case prolog.Compound:
+		for _, arg := range v.Args {
+			names = queryVariables(arg, names)
+		}
+	case prolog.List:
+		for _, e := range v.Elements {
+			names = queryVariables(e, names)
+		}
+		if v.Tail != nil {
+			names = queryVariables(v.Tail, names)
+		}
+	}
+	return names
+}
+
+// unify binds the given pattern, either a variable or a ground term, to the given value. A nil value leaves the
+// pattern unbound.
+func unify(pattern, value prolog.Term, bindings map[prolog.Variable]prolog.Term) bool {
+	if value == nil {
+		return true
+	}
+	v, ok := pattern.(prolog.Variable)
+	if !ok {
+		return pattern.String() == value.String()
+	}
+	if v == "_" {
+		return true
+	}
+	if bound, ok := bindings[v]; ok {
+		return bound.String() == value.String()
+	}
+	bindings[v] = value
+	return true
+}
+
+// substitute replaces the bound variables of a term by their bindings.
+func substitute(t prolog.Term, bindings map[prolog.Variable]prolog.Term) prolog.Term {
+	switch v := t.(type) {
+	case prolog.Variable:
+		if bound, ok := bindings[v]; ok {
+			return bound
+		}
+		return v
+	case prolog.Compound:
+		args := make([]prolog.Term, 0, len(v.Args))
+		for _, arg := range v.Args {
+			args = append(args, substitute(arg, bindings))
+		}
+		return prolog.Compound{Functor: v.Functor, Args: args}
+	case prolog.List:
+		elements := make([]prolog.Term, 0, len(v.Elements))
+		for _, e := range v.Elements {
+			elements = append(elements, substitute(e, bindings))
+		}
+		list := prolog.List{Elements: elements}
+		if v.Tail != nil {
+			list.Tail = substitute(v.Tail, bindings)
+		}
+		return list
+	default:
+		return t
+	}
+}
+
+func atomArg(t prolog.Term) (string, error) {
+	atom, ok := t.(prolog.Atom)
+	if !ok {
+		return "", errors.New("error(instantiation_error,root)")
+	}
+	return string(atom), nil
+}
+
+func existenceError(goal prolog.Term) error {
+	indicator := goal.String()
+	if c, ok := goal.(prolog.Compound); ok {
+		indicator = fmt.Sprintf("%s/%d", c.Functor, len(c.Args))
+	}
+	return fmt.Errorf("error(existence_error(procedure,%s),root)", indicator)
+}
@@ -0,0 +1,256 @@
+package dataverse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+	"github.com/axone-protocol/axone-sdk/credential"
+	"github.com/axone-protocol/axone-sdk/credential/template"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/prolog"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const (
+	datasetDID = "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5"
+	zoneDID    = "did:key:zQ3shZsqDEHzUcHCqo5Jh4WW9pAV2tBv4iAQKTzGYtSxwc8Wz"
+	forgedDID  = "did:key:zQ3shs7auhJSmVJpiUbQWco6bxxEhSqWnVEPvaBHBRvBKw6Q3"
+
+	govCredentialType     = dataverse.W3IDPrefix + "/schema/credential/governance/text/GovernanceTextCredential"
+	datasetCredentialType = dataverse.W3IDPrefix + "/schema/credential/dataset/description/DatasetDescriptionCredential"
+)
+
+func TestMemoryDataverse(t *testing.T) {
+	Convey("Given a memory dataverse with a governed dataset", t, func() {
+		ctx := context.Background()
+		loader, err := testutil.MockDocumentLoader()
+		So(err, ShouldBeNil)
+		dv := dataverse.NewMemoryDataverse(dataverse.WithDocumentLoader(loader))
+
+		parser := credential.WithParser(credential.NewDefaultParser(loader))
+		govVC, err := credential.New(template.NewGovernance(datasetDID, "contract:law-stone:gov1"), parser).Generate()
+		So(err, ShouldBeNil)
+		datasetVC, err := credential.New(template.NewDataset(datasetDID, "Memory dataset"), parser).Generate()
+		So(err, ShouldBeNil)
+
+		govSubmission, err := dv.SubmitClaims(ctx, govVC)
+		So(err, ShouldBeNil)
+		So(govSubmission.Height, ShouldEqual, 1)
+		_, err = dv.SubmitClaims(ctx, datasetVC)
+		So(err, ShouldBeNil)
+
+		Convey("When the governance of the dataset is resolved", func() {
+			addr, err := dv.GetResourceGovAddr(ctx, datasetDID)
+
+			Convey("Then the address of the submitted governance should be returned", func() {
+				So(err, ShouldBeNil)
+				So(addr, ShouldEqual, "gov1")
+			})
+		})
+
+		Convey("When a resource is a member of a governed zone", func() {
+			zoneGovVC, err := credential.New(template.NewGovernance(zoneDID, "contract:law-stone:zonegov"), parser).Generate()
+			So(err, ShouldBeNil)
+			membershipVC, err := credential.New(template.NewZoneMembership(otherDID, zoneDID), parser).Generate()
+			So(err, ShouldBeNil)
+			forgedVC, err := credential.New(template.NewZoneMembership(forgedDID, zoneDID), parser).Generate()
+			So(err, ShouldBeNil)
+			forgedVC.Issuer.ID = otherDID
+			for _, vc := range []*verifiable.Credential{zoneGovVC, membershipVC, forgedVC} {
+				_, err := dv.SubmitClaims(ctx, vc)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then it should inherit the governance of the zone only if the zone claims its membership", func() {
+				addr, err := dv.GetResourceGovAddr(ctx, otherDID)
+				So(err, ShouldBeNil)
+				So(addr, ShouldEqual, "zonegov")
+
+				_, err = dv.GetResourceGovAddr(ctx, forgedDID)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, dataverse.NewDVError(dataverse.ErrNoResult, nil).Error())
+			})
+
+			Convey("And the decisions about resources under both governances are asked together", func() {
+				So(dv.RegisterGov(ctx, "gov1", govProgram), ShouldBeNil)
+				So(dv.RegisterGov(ctx, "zonegov", govProgram), ShouldBeNil)
+				decisions, err := dv.AskResourcesDecisions(ctx, []dataverse.ResourcePermission{
+					{Resource: datasetDID, Permission: dataverse.Permission{DID: otherDID, Action: "write"}},
+					{Resource: otherDID, Permission: dataverse.Permission{DID: datasetDID, Action: "write"}},
+				})
+
+				Convey("Then each resource should be decided by its own governance", func() {
+					So(err, ShouldBeNil)
+					So(decisions, ShouldHaveLength, 2)
+					So(decisions[0].GovAddr, ShouldEqual, "gov1")
+					So(decisions[0].Permitted(), ShouldBeFalse)
+					So(decisions[1].GovAddr, ShouldEqual, "zonegov")
+					So(decisions[1].Permitted(), ShouldBeTrue)
+
+					decisions, err = dv.AskResourcesDecisions(ctx, []dataverse.ResourcePermission{
+						{Resource: forgedDID, Permission: dataverse.Permission{DID: datasetDID, Action: "read"}},
+					})
+					So(err, ShouldBeNil)
+					So(decisions, ShouldResemble, []*dataverse.Decision{nil})
+				})
+			})
+		})
+
+		Convey("When the claims about the dataset are fetched", func() {
+			claims, err := dv.GetSubjectClaims(ctx, datasetDID)
+
+			Convey("Then the claims of both credentials should be returned", func() {
+				So(err, ShouldBeNil)
+				So(claims, ShouldContainKey, govCredentialType)
+				So(claims[datasetCredentialType], ShouldHaveLength, 1)
+				So(claims[datasetCredentialType][0].CredentialID, ShouldEqual, datasetVC.ID)
+				So(claims[datasetCredentialType][0].Issuer, ShouldEqual, datasetDID)
+				So(claims[datasetCredentialType][0].Properties[dataverse.W3IDPrefix+"/schema/credential/dataset/description/hasTitle"],
+					ShouldResemble, []string{"Memory dataset"})
+			})
+		})
+
+		Convey("When the credentials are selected", func() {
+			var credentials []string
+			query := cgschema.SelectQuery{
+				Select: []cgschema.SelectItem{{Variable: toAddress(cgschema.SelectItem_Variable("credential"))}},
+				Where: cgschema.WhereClause{Bgp: &cgschema.WhereClause_Bgp{Patterns: []cgschema.TriplePattern{{
+					Subject:   cgschema.VarOrNode{Variable: toAddress(cgschema.VarOrNode_Variable("credential"))},
+					Predicate: cgschema.VarOrNamedNode{NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &dataverse.VcBodySubject}},
+					Object: cgschema.VarOrNodeOrLiteral{Node: &cgschema.VarOrNodeOrLiteral_Node{
+						NamedNode: &cgschema.Node_NamedNode{Full: toAddress(cgschema.IRI_Full(datasetDID))},
+					}},
+				}}}},
+			}
+			bindings, err := dv.FetchBindings(ctx, query)
+			So(err, ShouldBeNil)
+			for _, binding := range bindings {
+				credentials = append(credentials, string(*binding["credential"].ValueType.(cgschema.URI).Value.Full))
+			}
+
+			Convey("Then all the credentials should be returned", func() {
+				So(credentials, ShouldHaveLength, 2)
+				So(credentials, ShouldContain, govVC.ID)
+				So(credentials, ShouldContain, datasetVC.ID)
+			})
+		})
+
+		Convey("When a credential is submitted twice", func() {
+			_, err := dv.SubmitClaims(ctx, datasetVC)
+
+			Convey("Then the submission should fail", func() {
+				So(err.Error(), ShouldEqual, dataverse.NewDVError(
+					dataverse.ErrTxFailed,
+					fmt.Errorf("credential %s already submitted", datasetVC.ID),
+				).Error())
+			})
+		})
+
+		Convey("When a credential submission is simulated", func() {
+			otherVC, err := credential.New(template.NewDataset(otherDID, "Simulated dataset"), parser).Generate()
+			So(err, ShouldBeNil)
+			resp, err := dv.SubmitClaims(ctx, otherVC, dataverse.WithSimulation())
+
+			Convey("Then its claims should not be recorded", func() {
+				So(err, ShouldBeNil)
+				So(resp.Height, ShouldEqual, 0)
+				claims, err := dv.GetSubjectClaims(ctx, otherDID)
+				So(err, ShouldBeNil)
+				So(claims, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the dataset credential is revoked", func() {
+			_, err := dv.RevokeClaims(ctx, datasetVC.ID)
+			So(err, ShouldBeNil)
+			_, errAgain := dv.RevokeClaims(ctx, datasetVC.ID)
+
+			Convey("Then its claims should no longer be returned", func() {
+				claims, err := dv.GetSubjectClaims(ctx, datasetDID)
+				So(err, ShouldBeNil)
+				So(claims, ShouldContainKey, govCredentialType)
+				So(claims, ShouldNotContainKey, datasetCredentialType)
+				So(errAgain.Error(), ShouldContainSubstring, "not found")
+			})
+		})
+
+		Convey("When a Prolog program is registered as governance", func() {
+			So(dv.RegisterGov(ctx, "gov1", govProgram, dataverse.WithBlock("axone-localnet", 200, time.Unix(1700000000, 0))), ShouldBeNil)
+
+			Convey("Then the governance should answer from the program", func() {
+				permitted, err := dv.AskGovTellAction(ctx, "gov1", ownerDID, "write")
+				So(err, ShouldBeNil)
+				So(permitted, ShouldBeTrue)
+
+				decision, err := dv.AskGovDecision(ctx, "gov1", otherDID, "read")
+				So(err, ShouldBeNil)
+				So(decision.Result, ShouldEqual, prolog.Atom("prohibited"))
+
+				decisions, err := dv.AskResourcesDecisions(ctx, []dataverse.ResourcePermission{
+					{Resource: datasetDID, Permission: dataverse.Permission{DID: ownerDID, Action: "read"}},
+				})
+				So(err, ShouldBeNil)
+				So(decisions, ShouldHaveLength, 1)
+				So(decisions[0].Permitted(), ShouldBeTrue)
+			})
+
+			Convey("And the governance is broken", func() {
+				_, err := dv.BreakGov(ctx, "gov1")
+				So(err, ShouldBeNil)
+
+				Convey("Then it should be reported as broken", func() {
+					broken, err := dv.IsGovBroken(ctx, "gov1")
+					So(err, ShouldBeNil)
+					So(broken, ShouldBeTrue)
+
+					addr, err := dv.GetResourceGovAddr(ctx, datasetDID)
+					So(err, ShouldBeNil)
+					So(addr, ShouldEqual, "gov1")
+					_, err = dv.AskGovDecision(ctx, addr, ownerDID, "read")
+					So(err.Error(), ShouldEqual, dataverse.NewDVError(
+						dataverse.ErrBrokenGov,
+						fmt.Errorf("law-stone contract gov1"),
+					).Error())
+				})
+			})
+		})
+
+		Convey("When a Go policy is registered as governance", func() {
+			dv.RegisterGovPolicy("gov1", func(_ context.Context, did, action string) (prolog.Term, prolog.Term, error) {
+				if did == ownerDID || action == "read" {
+					return dataverse.PermittedResult, nil, nil
+				}
+				return prolog.Atom("prohibited"), prolog.Atom("not_owner"), nil
+			}, "read", "write")
+
+			Convey("Then the governance should answer from the policy", func() {
+				actions, err := dv.AskGovPermittedActions(ctx, "gov1", otherDID)
+				So(err, ShouldBeNil)
+				So(actions, ShouldResemble, []string{"read"})
+
+				decisions, err := dv.AskGovDecisions(ctx, "gov1", []dataverse.Permission{
+					{DID: ownerDID, Action: "write"},
+					{DID: otherDID, Action: "write"},
+				})
+				So(err, ShouldBeNil)
+				So(decisions, ShouldHaveLength, 2)
+				So(decisions[0].Permitted(), ShouldBeTrue)
+				So(decisions[1].Result, ShouldEqual, prolog.Atom("prohibited"))
+				So(decisions[1].Evidence, ShouldEqual, prolog.Atom("not_owner"))
+			})
+		})
+
+		Convey("When an unknown governance is asked", func() {
+			_, err := dv.AskGovTellAction(ctx, "unknown", ownerDID, "read")
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -3,7 +3,9 @@ package dataverse
 import cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
 
 var (
-	VcBodySubject = cgschema.IRI_Full("dataverse:credential:body#subject")
-	VcBodyType    = cgschema.IRI_Full("dataverse:credential:body#type")
-	VcBodyClaim   = cgschema.IRI_Full("dataverse:credential:body#claim")
+	VcBodySubject   = cgschema.IRI_Full("dataverse:credential:body#subject")
+	VcBodyType      = cgschema.IRI_Full("dataverse:credential:body#type")
+	VcBodyClaim     = cgschema.IRI_Full("dataverse:credential:body#claim")
+	VcBodyIssuer    = cgschema.IRI_Full("dataverse:credential:body#issuer")
+	VcBodyValidFrom = cgschema.IRI_Full("dataverse:credential:body#validFrom")
 )
@@ -0,0 +1,76 @@
+package dataverse
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+)
+
+// Publication is a location where a digital resource has been published, as claimed by a
+// DigitalResourcePublicationCredential.
+type Publication struct {
+	// CredentialID is the identifier of the publication credential.
+	CredentialID string
+	// URI at which the resource can be retrieved.
+	URI string
+	// StorageDID is the DID of the storage service serving the resource.
+	StorageDID string
+	// IssuanceDate of the publication credential.
+	IssuanceDate time.Time
+}
+
+func (c *queryClient) GetResourcePublications(ctx context.Context, resourceDID string) ([]Publication, error) {
+	maxLimit, err := c.maxQueryLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.cognitariumClient.Select(ctx, &cgschema.QueryMsg_Select{
+		Query: buildGetResourcePublicationsRequest(resourceDID, maxLimit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Results.Bindings) >= maxLimit {
+		return nil, NewDVError(ErrQueryLimit, fmt.Errorf("more than %d publications of %s", maxLimit, resourceDID))
+	}
+
+	publications := make([]Publication, 0, len(response.Results.Bindings))
+	for _, binding := range response.Results.Bindings {
+		publication, err := publicationFromBinding(binding)
+		if err != nil {
+			return nil, err
+		}
+		publications = append(publications, publication)
+	}
+
+	return publications, nil
+}
+
+func publicationFromBinding(binding map[string]cgschema.Value) (Publication, error) {
+	credID, err := bindingIRI(binding, "credId")
+	if err != nil {
+		return Publication{}, err
+	}
+	uri, err := bindingIRI(binding, "uri")
+	if err != nil {
+		return Publication{}, err
+	}
+	service, err := bindingIRI(binding, "service")
+	if err != nil {
+		return Publication{}, err
+	}
+	issuanceDate, err := bindingDateTime(binding, "issuedAt")
+	if err != nil {
+		return Publication{}, err
+	}
+
+	return Publication{
+		CredentialID: credID,
+		URI:          uri,
+		StorageDID:   service,
+		IssuanceDate: issuanceDate,
+	}, nil
+}
@@ -0,0 +1,148 @@
+package dataverse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestClient_GetResourcePublications(t *testing.T) {
+	tests := []struct {
+		name          string
+		resourceDID   string
+		response      *cgschema.SelectResponse
+		responseError error
+		wantErr       error
+		wantResult    []dataverse.Publication
+	}{
+		{
+			name:        "resource published twice",
+			resourceDID: "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+			response: &cgschema.SelectResponse{
+				Head: cgschema.Head{Vars: []string{"credId", "uri", "service", "issuedAt"}},
+				Results: cgschema.Results{
+					Bindings: []map[string]cgschema.Value{
+						{
+							"credId":   uriValue("cred:1"),
+							"uri":      uriValue("https://storage-a.example.org/dataset"),
+							"service":  uriValue("did:key:storageA"),
+							"issuedAt": literalValue("2024-01-01T00:00:00Z"),
+						},
+						{
+							"credId":   uriValue("cred:2"),
+							"uri":      uriValue("https://storage-b.example.org/dataset"),
+							"service":  uriValue("did:key:storageB"),
+							"issuedAt": literalValue("2024-02-01T10:00:00+02:00"),
+						},
+					},
+				},
+			},
+			wantResult: []dataverse.Publication{
+				{
+					CredentialID: "cred:1",
+					URI:          "https://storage-a.example.org/dataset",
+					StorageDID:   "did:key:storageA",
+					IssuanceDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					CredentialID: "cred:2",
+					URI:          "https://storage-b.example.org/dataset",
+					StorageDID:   "did:key:storageB",
+					IssuanceDate: time.Date(2024, 2, 1, 10, 0, 0, 0, time.FixedZone("", 2*60*60)),
+				},
+			},
+		},
+		{
+			name:        "resource never published",
+			resourceDID: "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+			response: &cgschema.SelectResponse{
+				Head:    cgschema.Head{Vars: []string{"credId", "uri", "service", "issuedAt"}},
+				Results: cgschema.Results{Bindings: []map[string]cgschema.Value{}},
+			},
+			wantResult: []dataverse.Publication{},
+		},
+		{
+			name:        "publications exceeding the query limit",
+			resourceDID: "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+			response: &cgschema.SelectResponse{
+				Results: cgschema.Results{
+					Bindings: []map[string]cgschema.Value{{}, {}, {}},
+				},
+			},
+			wantErr: dataverse.NewDVError(dataverse.ErrQueryLimit,
+				fmt.Errorf("more than 3 publications of did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5")),
+		},
+		{
+			name:          "grpc error",
+			resourceDID:   "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+			responseError: fmt.Errorf("gRPC: connection refused"),
+			wantErr:       fmt.Errorf("gRPC: connection refused"),
+		},
+		{
+			name:        "missing variable in response",
+			resourceDID: "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+			response: &cgschema.SelectResponse{
+				Results: cgschema.Results{
+					Bindings: []map[string]cgschema.Value{
+						{
+							"credId": uriValue("cred:1"),
+							"uri":    uriValue("https://storage-a.example.org/dataset"),
+						},
+					},
+				},
+			},
+			wantErr: dataverse.NewDVError(dataverse.ErrVarNotFound, nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a mocked cognitarium client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				mockCognitarium := testutil.NewMockCognitariumQueryClient(controller)
+				mockCognitarium.
+					EXPECT().
+					Store(gomock.Any(), gomock.Any()).
+					Return(&cgschema.StoreResponse{Limits: cgschema.StoreLimits{MaxQueryLimit: 3}}, nil).
+					Times(1)
+				mockCognitarium.
+					EXPECT().
+					Select(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *cgschema.QueryMsg_Select, _ ...any) (*cgschema.SelectResponse, error) {
+						So(*req.Query.Limit, ShouldEqual, 3)
+						return test.response, test.responseError
+					}).
+					Times(1)
+
+				client := dataverse.NewDataverseQueryClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					mockCognitarium,
+					nil,
+				)
+
+				Convey("When GetResourcePublications is called", func() {
+					publications, err := client.GetResourcePublications(context.Background(), test.resourceDID)
+
+					Convey("Then the publications of the resource should be returned", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(publications, ShouldResemble, test.wantResult)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(publications, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
@@ -2,6 +2,7 @@ package dataverse
 
 import (
 	"fmt"
+	"time"
 
 	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
 )
@@ -12,35 +13,468 @@ func ref[T any](v T) *T {
 	return &v
 }
 
-//nolint:funlen
+// buildGetResourceGovAddrRequest selects the governance address of the given resource.
 func buildGetResourceGovAddrRequest(resource string) cgschema.SelectQuery {
 	return cgschema.SelectQuery{
-		Limit: ref(1),
+		Limit:    ref(1),
+		Prefixes: govPrefixes(),
+		Select: []cgschema.SelectItem{
+			{Variable: ref(cgschema.SelectItem_Variable("code"))},
+		},
+		Where: cgschema.WhereClause{
+			Bgp: &cgschema.WhereClause_Bgp{Patterns: resourceGovPatterns(iriObject(resource))},
+		},
+	}
+}
+
+// buildGetResourcesGovAddrRequest selects the governance addresses, as `code` variable, of the given resources, as
+// `resource` variable.
+func buildGetResourcesGovAddrRequest(resources []string, limit int) cgschema.SelectQuery {
+	return cgschema.SelectQuery{
+		Limit:    ref(limit),
+		Prefixes: govPrefixes(),
+		Select: []cgschema.SelectItem{
+			{Variable: ref(cgschema.SelectItem_Variable("resource"))},
+			{Variable: ref(cgschema.SelectItem_Variable("code"))},
+		},
+		Where: cgschema.WhereClause{
+			Filter: &cgschema.WhereClause_Filter{
+				Expr: oneOfExpression("resource", resources),
+				Inner: cgschema.WhereClause{
+					Bgp: &cgschema.WhereClause_Bgp{Patterns: resourceGovPatterns(variableObject("resource"))},
+				},
+			},
+		},
+	}
+}
+
+// resourceGovPatterns binds the `code` variable to the governance addresses of the given resource.
+func resourceGovPatterns(resource cgschema.VarOrNodeOrLiteral) []cgschema.TriplePattern {
+	return []cgschema.TriplePattern{
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodySubject},
+			},
+			Object: resource,
+		},
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyType},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{
+				Node: &cgschema.VarOrNodeOrLiteral_Node{
+					NamedNode: &cgschema.Node_NamedNode{Prefixed: ref(cgschema.IRI_Prefixed("gov:GovernanceTextCredential"))},
+				},
+			},
+		},
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyClaim},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("claim"))},
+		},
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("claim"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Prefixed: ref(cgschema.IRI_Prefixed("gov:isGovernedBy"))},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("gov"))},
+		},
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("gov"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Prefixed: ref(cgschema.IRI_Prefixed("gov:fromGovernance"))},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("code"))},
+		},
+	}
+}
+
+func govPrefixes() []cgschema.Prefix {
+	return []cgschema.Prefix{
+		{
+			Prefix:    "gov",
+			Namespace: fmt.Sprintf("%s/schema/credential/governance/text/", W3IDPrefix),
+		},
+	}
+}
+
+// iriObject is the object of a triple pattern matching the given IRI.
+func iriObject(iri string) cgschema.VarOrNodeOrLiteral {
+	return cgschema.VarOrNodeOrLiteral{
+		Node: &cgschema.VarOrNodeOrLiteral_Node{
+			NamedNode: &cgschema.Node_NamedNode{Full: ref(cgschema.IRI_Full(iri))},
+		},
+	}
+}
+
+// variableObject is the object of a triple pattern matching the given variable.
+func variableObject(variable string) cgschema.VarOrNodeOrLiteral {
+	return cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable(variable))}
+}
+
+// buildDescribeResourceRequest describes every claim node made about the given resource, whatever the credential
+// carrying it.
+func buildDescribeResourceRequest(resource string) cgschema.DescribeQuery {
+	return cgschema.DescribeQuery{
+		Prefixes: []cgschema.Prefix{},
+		Resource: cgschema.VarOrNamedNode{Variable: ref(cgschema.VarOrNamedNode_Variable("claim"))},
+		Where: &cgschema.WhereClause{
+			Bgp: &cgschema.WhereClause_Bgp{
+				Patterns: resourceClaimPatterns(resource),
+			},
+		},
+	}
+}
+
+// buildConstructResourceRequest builds a graph having the given resource as subject of all the properties claimed
+// about it, the intermediate credential and claim nodes are left out.
+func buildConstructResourceRequest(resource string) cgschema.ConstructQuery {
+	return cgschema.ConstructQuery{
+		Prefixes: []cgschema.Prefix{},
+		Construct: []cgschema.TripleConstructTemplate{
+			{
+				Subject: cgschema.VarOrNode{
+					Node: &cgschema.VarOrNode_Node{NamedNode: &cgschema.Node_NamedNode{Full: ref(cgschema.IRI_Full(resource))}},
+				},
+				Predicate: cgschema.VarOrNamedNode{Variable: ref(cgschema.VarOrNamedNode_Variable("p"))},
+				Object:    cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("o"))},
+			},
+		},
+		Where: cgschema.WhereClause{
+			Bgp: &cgschema.WhereClause_Bgp{
+				Patterns: append(resourceClaimPatterns(resource), cgschema.TriplePattern{
+					Subject:   cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("claim"))},
+					Predicate: cgschema.VarOrNamedNode{Variable: ref(cgschema.VarOrNamedNode_Variable("p"))},
+					Object:    cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("o"))},
+				}),
+			},
+		},
+	}
+}
+
+// resourceClaimPatterns binds the `credId` and `claim` variables to the credentials and claims having the given
+// resource as subject.
+func resourceClaimPatterns(resource string) []cgschema.TriplePattern {
+	return []cgschema.TriplePattern{
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodySubject},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{
+				Node: &cgschema.VarOrNodeOrLiteral_Node{
+					NamedNode: &cgschema.Node_NamedNode{Full: ref(cgschema.IRI_Full(resource))},
+				},
+			},
+		},
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyClaim},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("claim"))},
+		},
+	}
+}
+
+// buildSearchDatasetsRequest selects the dataset description credentials matching the given filter, except for the
+// title which cannot be matched by the cognitarium as a substring.
+func buildSearchDatasetsRequest(filter DatasetFilter, limit *int) cgschema.SelectQuery {
+	patterns := []cgschema.TriplePattern{
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyType},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{
+				Node: &cgschema.VarOrNodeOrLiteral_Node{
+					NamedNode: &cgschema.Node_NamedNode{Prefixed: ref(cgschema.IRI_Prefixed("ds:DatasetDescriptionCredential"))},
+				},
+			},
+		},
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodySubject},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("dataset"))},
+		},
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyClaim},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("claim"))},
+		},
+		claimPattern("ds:hasTitle", cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("title"))}),
+	}
+
+	for _, tag := range filter.Tags {
+		patterns = append(patterns, claimPattern("ds:hasTag", cgschema.VarOrNodeOrLiteral{
+			Literal: &cgschema.VarOrNodeOrLiteral_Literal{Simple: ref(cgschema.Literal_Simple(tag))},
+		}))
+	}
+	if filter.Topic != "" {
+		patterns = append(patterns, claimPattern("ds:hasTopic", cgschema.VarOrNodeOrLiteral{
+			Node: &cgschema.VarOrNodeOrLiteral_Node{
+				NamedNode: &cgschema.Node_NamedNode{Full: ref(cgschema.IRI_Full(filter.Topic))},
+			},
+		}))
+	}
+	if filter.Format != "" {
+		patterns = append(patterns, claimPattern("ds:hasFormat", cgschema.VarOrNodeOrLiteral{
+			Node: &cgschema.VarOrNodeOrLiteral_Node{
+				NamedNode: &cgschema.Node_NamedNode{Full: ref(cgschema.IRI_Full(filter.Format))},
+			},
+		}))
+	}
+
+	return cgschema.SelectQuery{
+		Limit: limit,
 		Prefixes: []cgschema.Prefix{
 			{
-				Prefix:    "gov",
-				Namespace: fmt.Sprintf("%s/schema/credential/governance/text/", W3IDPrefix),
+				Prefix:    "ds",
+				Namespace: fmt.Sprintf("%s/schema/credential/dataset/description/", W3IDPrefix),
 			},
 		},
 		Select: []cgschema.SelectItem{
-			{
-				Variable: ref(cgschema.SelectItem_Variable("code")),
+			{Variable: ref(cgschema.SelectItem_Variable("credId"))},
+			{Variable: ref(cgschema.SelectItem_Variable("dataset"))},
+			{Variable: ref(cgschema.SelectItem_Variable("title"))},
+		},
+		Where: cgschema.WhereClause{
+			Bgp: &cgschema.WhereClause_Bgp{Patterns: patterns},
+		},
+	}
+}
+
+// buildCredentialsClaimRequest selects all the properties, as `p` and `o` variables, of the claims carried by the
+// given credentials, bound to the `credId` variable.
+func buildCredentialsClaimRequest(credIDs []string, limit int) cgschema.SelectQuery {
+	return cgschema.SelectQuery{
+		Limit:    ref(limit),
+		Prefixes: []cgschema.Prefix{},
+		Select: []cgschema.SelectItem{
+			{Variable: ref(cgschema.SelectItem_Variable("credId"))},
+			{Variable: ref(cgschema.SelectItem_Variable("p"))},
+			{Variable: ref(cgschema.SelectItem_Variable("o"))},
+		},
+		Where: cgschema.WhereClause{
+			Filter: &cgschema.WhereClause_Filter{
+				Expr: oneOfExpression("credId", credIDs),
+				Inner: cgschema.WhereClause{
+					Bgp: &cgschema.WhereClause_Bgp{
+						Patterns: []cgschema.TriplePattern{
+							{
+								Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
+								Predicate: cgschema.VarOrNamedNode{
+									NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyClaim},
+								},
+								Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("claim"))},
+							},
+							{
+								Subject:   cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("claim"))},
+								Predicate: cgschema.VarOrNamedNode{Variable: ref(cgschema.VarOrNamedNode_Variable("p"))},
+								Object:    cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("o"))},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// oneOfExpression matches the solutions having the given variable bound to one of the given IRIs.
+func oneOfExpression(variable string, iris []string) cgschema.Expression {
+	alternatives := make(cgschema.Expression_Or, 0, len(iris))
+	for _, iri := range iris {
+		alternatives = append(alternatives, cgschema.Expression{
+			Equal: &cgschema.Expression_Equal{
+				F0: cgschema.Expression{Variable: ref(cgschema.Expression_Variable(variable))},
+				F1: cgschema.Expression{NamedNode: &cgschema.Expression_NamedNode{Full: ref(cgschema.IRI_Full(iri))}},
+			},
+		})
+	}
+
+	return cgschema.Expression{Or: &alternatives}
+}
+
+// claimPattern matches the given prefixed property of the claim bound to the `claim` variable.
+func claimPattern(property string, object cgschema.VarOrNodeOrLiteral) cgschema.TriplePattern {
+	return cgschema.TriplePattern{
+		Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("claim"))},
+		Predicate: cgschema.VarOrNamedNode{
+			NamedNode: &cgschema.VarOrNamedNode_NamedNode{Prefixed: ref(cgschema.IRI_Prefixed(property))},
+		},
+		Object: object,
+	}
+}
+
+// bindingIRI returns the full IRI bound to the given variable.
+func bindingIRI(binding map[string]cgschema.Value, variable string) (string, error) {
+	value, ok := binding[variable]
+	if !ok {
+		return "", NewDVError(ErrVarNotFound, nil)
+	}
+	uri, ok := value.ValueType.(cgschema.URI)
+	if !ok {
+		return "", NewDVError(ErrType, fmt.Errorf("expected URI, got %T", value.ValueType))
+	}
+	if uri.Value.Full == nil {
+		return "", NewDVError(ErrType, fmt.Errorf("expected full IRI"))
+	}
+
+	return string(*uri.Value.Full), nil
+}
+
+// bindingLiteral returns the lexical form of the literal bound to the given variable.
+func bindingLiteral(binding map[string]cgschema.Value, variable string) (string, error) {
+	value, ok := binding[variable]
+	if !ok {
+		return "", NewDVError(ErrVarNotFound, nil)
+	}
+	literal, ok := value.ValueType.(cgschema.Value_Literal)
+	if !ok {
+		return "", NewDVError(ErrType, fmt.Errorf("expected literal, got %T", value.ValueType))
+	}
+
+	return literal.Value, nil
+}
+
+// bindingDateTime returns the date bound to the given variable as a RFC3339 literal.
+func bindingDateTime(binding map[string]cgschema.Value, variable string) (time.Time, error) {
+	value, err := bindingLiteral(binding, variable)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, NewDVError(ErrType, fmt.Errorf("expected RFC3339 date, got %s", value))
+	}
+
+	return t, nil
+}
+
+// bindingString returns either the full IRI, the lexical form of the literal or the `_:` prefixed label of the blank
+// node bound to the given variable.
+func bindingString(binding map[string]cgschema.Value, variable string) (string, error) {
+	if value, ok := binding[variable]; ok {
+		switch v := value.ValueType.(type) {
+		case cgschema.Value_Literal:
+			return v.Value, nil
+		case cgschema.BlankNode:
+			return "_:" + v.Value, nil
+		}
+	}
+
+	return bindingIRI(binding, variable)
+}
+
+// buildSubjectCredentialsRequest selects the identifier, types, issuer and issuance date of the credentials having the
+// given resource as subject, up to the given limit.
+func buildSubjectCredentialsRequest(resource string, limit int) cgschema.SelectQuery {
+	credPattern := func(predicate *cgschema.IRI_Full, variable string) cgschema.TriplePattern {
+		return cgschema.TriplePattern{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: predicate},
 			},
+			Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable(variable))},
+		}
+	}
+
+	return cgschema.SelectQuery{
+		Prefixes: []cgschema.Prefix{},
+		Limit:    ref(limit),
+		Select: []cgschema.SelectItem{
+			{Variable: ref(cgschema.SelectItem_Variable("credId"))},
+			{Variable: ref(cgschema.SelectItem_Variable("type"))},
+			{Variable: ref(cgschema.SelectItem_Variable("issuer"))},
+			{Variable: ref(cgschema.SelectItem_Variable("issuedAt"))},
 		},
 		Where: cgschema.WhereClause{
 			Bgp: &cgschema.WhereClause_Bgp{
 				Patterns: []cgschema.TriplePattern{
-					{
+					resourceClaimPatterns(resource)[0],
+					credPattern(&VcBodyType, "type"),
+					credPattern(&VcBodyIssuer, "issuer"),
+					credPattern(&VcBodyValidFrom, "issuedAt"),
+				},
+			},
+		},
+	}
+}
+
+// buildGetResourcePublicationsRequest selects the URI, serving service and issuance date of the digital resource
+// publication credentials of the given resource, up to the given limit.
+func buildGetResourcePublicationsRequest(resource string, limit int) cgschema.SelectQuery {
+	return cgschema.SelectQuery{
+		Limit: ref(limit),
+		Prefixes: []cgschema.Prefix{
+			{
+				Prefix:    "pub",
+				Namespace: fmt.Sprintf("%s/schema/credential/digital-resource/publication/", W3IDPrefix),
+			},
+		},
+		Select: []cgschema.SelectItem{
+			{Variable: ref(cgschema.SelectItem_Variable("credId"))},
+			{Variable: ref(cgschema.SelectItem_Variable("uri"))},
+			{Variable: ref(cgschema.SelectItem_Variable("service"))},
+			{Variable: ref(cgschema.SelectItem_Variable("issuedAt"))},
+		},
+		Where: cgschema.WhereClause{
+			Bgp: &cgschema.WhereClause_Bgp{
+				Patterns: append(resourceClaimPatterns(resource),
+					cgschema.TriplePattern{
 						Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
 						Predicate: cgschema.VarOrNamedNode{
-							NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodySubject},
+							NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyType},
 						},
 						Object: cgschema.VarOrNodeOrLiteral{
 							Node: &cgschema.VarOrNodeOrLiteral_Node{
-								NamedNode: &cgschema.Node_NamedNode{Full: ref(cgschema.IRI_Full(resource))},
+								NamedNode: &cgschema.Node_NamedNode{
+									Prefixed: ref(cgschema.IRI_Prefixed("pub:DigitalResourcePublicationCredential")),
+								},
 							},
 						},
 					},
+					cgschema.TriplePattern{
+						Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
+						Predicate: cgschema.VarOrNamedNode{
+							NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyValidFrom},
+						},
+						Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("issuedAt"))},
+					},
+					claimPattern("pub:hasIdentifier", cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("uri"))}),
+					claimPattern("pub:servedBy", cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("service"))}),
+				),
+			},
+		},
+	}
+}
+
+// buildGetZoneMembersRequest selects the resources claimed to be members of the given zone by a
+// ZoneMembershipCredential, up to the given limit.
+func buildGetZoneMembersRequest(zone string, limit int) cgschema.SelectQuery {
+	return cgschema.SelectQuery{
+		Limit: ref(limit),
+		Prefixes: []cgschema.Prefix{
+			{
+				Prefix:    "zone",
+				Namespace: fmt.Sprintf("%s/schema/credential/zone/membership/", W3IDPrefix),
+			},
+		},
+		Select: []cgschema.SelectItem{
+			{Variable: ref(cgschema.SelectItem_Variable("member"))},
+		},
+		Where: cgschema.WhereClause{
+			Bgp: &cgschema.WhereClause_Bgp{
+				Patterns: []cgschema.TriplePattern{
 					{
 						Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
 						Predicate: cgschema.VarOrNamedNode{
@@ -48,33 +482,179 @@ func buildGetResourceGovAddrRequest(resource string) cgschema.SelectQuery {
 						},
 						Object: cgschema.VarOrNodeOrLiteral{
 							Node: &cgschema.VarOrNodeOrLiteral_Node{
-								NamedNode: &cgschema.Node_NamedNode{Prefixed: ref(cgschema.IRI_Prefixed("gov:GovernanceTextCredential"))},
+								NamedNode: &cgschema.Node_NamedNode{
+									Prefixed: ref(cgschema.IRI_Prefixed("zone:ZoneMembershipCredential")),
+								},
 							},
 						},
 					},
 					{
 						Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
 						Predicate: cgschema.VarOrNamedNode{
-							NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyClaim},
+							NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodySubject},
 						},
-						Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("claim"))},
+						Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("member"))},
 					},
 					{
-						Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("claim"))},
+						Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
 						Predicate: cgschema.VarOrNamedNode{
-							NamedNode: &cgschema.VarOrNamedNode_NamedNode{Prefixed: ref(cgschema.IRI_Prefixed("gov:isGovernedBy"))},
+							NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyClaim},
 						},
-						Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("gov"))},
+						Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("claim"))},
 					},
-					{
-						Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("gov"))},
-						Predicate: cgschema.VarOrNamedNode{
-							NamedNode: &cgschema.VarOrNamedNode_NamedNode{Prefixed: ref(cgschema.IRI_Prefixed("gov:fromGovernance"))},
+					claimPattern("zone:isMemberOf", cgschema.VarOrNodeOrLiteral{
+						Node: &cgschema.VarOrNodeOrLiteral_Node{
+							NamedNode: &cgschema.Node_NamedNode{Full: ref(cgschema.IRI_Full(zone))},
 						},
-						Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("code"))},
+					}),
+				},
+			},
+		},
+	}
+}
+
+// buildGetZoneGovAddrRequest selects the governance addresses of the zones the given resource is a member of, as
+// claimed by a ZoneMembershipCredential issued by the zone or by the resource itself.
+func buildGetZoneGovAddrRequest(resource string) cgschema.SelectQuery {
+	return cgschema.SelectQuery{
+		Prefixes: zoneGovPrefixes(),
+		Select: []cgschema.SelectItem{
+			{Variable: ref(cgschema.SelectItem_Variable("code"))},
+		},
+		Where: cgschema.WhereClause{
+			Filter: &cgschema.WhereClause_Filter{
+				Expr: zoneIssuerExpression(cgschema.Expression{
+					NamedNode: &cgschema.Expression_NamedNode{Full: ref(cgschema.IRI_Full(resource))},
+				}),
+				Inner: cgschema.WhereClause{
+					Bgp: &cgschema.WhereClause_Bgp{Patterns: zoneGovPatterns(iriObject(resource))},
+				},
+			},
+		},
+	}
+}
+
+// buildGetZonesGovAddrRequest selects the governance addresses, as `code` variable, of the zones the given
+// resources, as `resource` variable, are members of, as buildGetZoneGovAddrRequest does for a single resource.
+func buildGetZonesGovAddrRequest(resources []string, limit int) cgschema.SelectQuery {
+	return cgschema.SelectQuery{
+		Limit:    ref(limit),
+		Prefixes: zoneGovPrefixes(),
+		Select: []cgschema.SelectItem{
+			{Variable: ref(cgschema.SelectItem_Variable("resource"))},
+			{Variable: ref(cgschema.SelectItem_Variable("code"))},
+		},
+		Where: cgschema.WhereClause{
+			Filter: &cgschema.WhereClause_Filter{
+				Expr: cgschema.Expression{And: &cgschema.Expression_And{
+					oneOfExpression("resource", resources),
+					zoneIssuerExpression(cgschema.Expression{Variable: ref(cgschema.Expression_Variable("resource"))}),
+				}},
+				Inner: cgschema.WhereClause{
+					Bgp: &cgschema.WhereClause_Bgp{Patterns: zoneGovPatterns(variableObject("resource"))},
+				},
+			},
+		},
+	}
+}
+
+// zoneIssuerExpression holds when the `issuer` variable is the `zone` variable or the given resource.
+func zoneIssuerExpression(resource cgschema.Expression) cgschema.Expression {
+	issuer := cgschema.Expression{Variable: ref(cgschema.Expression_Variable("issuer"))}
+	zone := cgschema.Expression{Variable: ref(cgschema.Expression_Variable("zone"))}
+
+	return cgschema.Expression{Or: &cgschema.Expression_Or{
+		{Equal: &cgschema.Expression_Equal{F0: issuer, F1: zone}},
+		{Equal: &cgschema.Expression_Equal{F0: issuer, F1: resource}},
+	}}
+}
+
+// zoneGovPatterns binds the `code` variable to the governance addresses of the zones the given resource is a member
+// of, and the `issuer` variable to the issuers of the ZoneMembershipCredential.
+//
+//nolint:funlen
+func zoneGovPatterns(resource cgschema.VarOrNodeOrLiteral) []cgschema.TriplePattern {
+	return []cgschema.TriplePattern{
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("membershipId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodySubject},
+			},
+			Object: resource,
+		},
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("membershipId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyType},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{
+				Node: &cgschema.VarOrNodeOrLiteral_Node{
+					NamedNode: &cgschema.Node_NamedNode{
+						Prefixed: ref(cgschema.IRI_Prefixed("zone:ZoneMembershipCredential")),
 					},
 				},
 			},
 		},
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("membershipId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyClaim},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("membership"))},
+		},
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("membershipId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyIssuer},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("issuer"))},
+		},
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("membership"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Prefixed: ref(cgschema.IRI_Prefixed("zone:isMemberOf"))},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("zone"))},
+		},
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodySubject},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("zone"))},
+		},
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyType},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{
+				Node: &cgschema.VarOrNodeOrLiteral_Node{
+					NamedNode: &cgschema.Node_NamedNode{Prefixed: ref(cgschema.IRI_Prefixed("gov:GovernanceTextCredential"))},
+				},
+			},
+		},
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("credId"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Full: &VcBodyClaim},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("claim"))},
+		},
+		claimPattern("gov:isGovernedBy", cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("gov"))}),
+		{
+			Subject: cgschema.VarOrNode{Variable: ref(cgschema.VarOrNode_Variable("gov"))},
+			Predicate: cgschema.VarOrNamedNode{
+				NamedNode: &cgschema.VarOrNamedNode_NamedNode{Prefixed: ref(cgschema.IRI_Prefixed("gov:fromGovernance"))},
+			},
+			Object: cgschema.VarOrNodeOrLiteral{Variable: ref(cgschema.VarOrNodeOrLiteral_Variable("code"))},
+		},
 	}
 }
+
+func zoneGovPrefixes() []cgschema.Prefix {
+	return append(govPrefixes(), cgschema.Prefix{
+		Prefix:    "zone",
+		Namespace: fmt.Sprintf("%s/schema/credential/zone/membership/", W3IDPrefix),
+	})
+}
@@ -0,0 +1,187 @@
+package dataverse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// QueryClientFactory creates the QueryClient of a dataverse, e.g. by connecting to a node and resolving the address of
+// its cognitarium contract.
+type QueryClientFactory func(ctx context.Context) (QueryClient, error)
+
+// Registry manages the QueryClient of several dataverses, e.g. the testnet, mainnet and private ones, by name.
+//
+// Each dataverse has its own connection and contract addresses. Its client is created on its first lookup, so that
+// registering dataverses does not connect to their nodes; a failed creation is retried on the next lookup. The
+// connections are closed with the registry.
+type Registry struct {
+	mu      sync.RWMutex
+	entries map[string]*registryEntry
+	closed  bool
+}
+
+type registryEntry struct {
+	factory QueryClientFactory
+
+	mu     sync.Mutex
+	client QueryClient
+	// creating is closed once the creation of the client in progress is done, nil if none is.
+	creating chan struct{}
+	closed   bool
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{entries: make(map[string]*registryEntry)}
+}
+
+// Register registers a dataverse under the given name, its client being created by the given factory on its first
+// lookup. It fails if a dataverse is already registered under this name.
+func (r *Registry) Register(name string, factory QueryClientFactory) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return NewDVError(ErrRegistryClosed, nil)
+	}
+	if _, ok := r.entries[name]; ok {
+		return NewDVError(ErrDataverseRegistered, fmt.Errorf("%s", name))
+	}
+	r.entries[name] = &registryEntry{factory: factory}
+
+	return nil
+}
+
+// RegisterGRPC registers under the given name the dataverse contract at the given address, queried through the gRPC
+// endpoint of a node as NewQueryClient does.
+func (r *Registry) RegisterGRPC(name, grpcAddr, contractAddr string, opts ...grpc.DialOption) error {
+	return r.Register(name, func(ctx context.Context) (QueryClient, error) {
+		return NewQueryClient(ctx, grpcAddr, contractAddr, opts...)
+	})
+}
+
+// RegisterREST registers under the given name the dataverse contract at the given address, queried through the REST
+// API of a node as NewRESTQueryClient does.
+func (r *Registry) RegisterREST(name, restAddr, contractAddr string, opts ...RESTOption) error {
+	return r.Register(name, func(ctx context.Context) (QueryClient, error) {
+		return NewRESTQueryClient(ctx, restAddr, contractAddr, opts...)
+	})
+}
+
+// Get returns the client of the dataverse registered under the given name, creating it if not done yet.
+//
+// A single client is created at once with the context of the caller creating it, the other callers waiting for it
+// until their own context is done.
+func (r *Registry) Get(ctx context.Context, name string) (QueryClient, error) {
+	r.mu.RLock()
+	entry, ok := r.entries[name]
+	closed := r.closed
+	r.mu.RUnlock()
+	if closed {
+		return nil, NewDVError(ErrRegistryClosed, nil)
+	}
+	if !ok {
+		return nil, NewDVError(ErrUnknownDataverse, fmt.Errorf("%s", name))
+	}
+
+	for {
+		entry.mu.Lock()
+		switch {
+		case entry.closed:
+			entry.mu.Unlock()
+			return nil, NewDVError(ErrRegistryClosed, nil)
+		case entry.client != nil:
+			client := entry.client
+			entry.mu.Unlock()
+			return client, nil
+		case entry.creating == nil:
+			entry.creating = make(chan struct{})
+			entry.mu.Unlock()
+			return entry.create(ctx, name)
+		}
+		creating := entry.creating
+		entry.mu.Unlock()
+
+		select {
+		case <-creating:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// create creates the client of the entry, the creation having been marked in progress by the caller. The waiting
+// callers are then released, to get the created client or to retry the creation if it failed.
+func (e *registryEntry) create(ctx context.Context, name string) (QueryClient, error) {
+	client, err := e.factory(ctx)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	close(e.creating)
+	e.creating = nil
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client of dataverse %s: %w", name, err)
+	}
+	if e.closed {
+		_ = closeClient(client)
+		return nil, NewDVError(ErrRegistryClosed, nil)
+	}
+	e.client = client
+
+	return client, nil
+}
+
+// Names returns the names of the registered dataverses, in alphabetical order.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.entries))
+	for name := range r.entries {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// Close closes the connections of the created clients, i.e. the clients implementing io.Closer as the ones created by
+// NewQueryClient do. The registry must not be used once closed: the lookups fail, as do the creations in progress.
+func (r *Registry) Close() error {
+	r.mu.Lock()
+	r.closed = true
+	entries := make(map[string]*registryEntry, len(r.entries))
+	maps.Copy(entries, r.entries)
+	r.mu.Unlock()
+
+	var errs []error
+	for name, entry := range entries {
+		entry.mu.Lock()
+		client := entry.client
+		entry.client = nil
+		entry.closed = true
+		entry.mu.Unlock()
+
+		if err := closeClient(client); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close client of dataverse %s: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// closeClient closes the given client if it holds a connection.
+func closeClient(client QueryClient) error {
+	if closer, ok := client.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package dataverse_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	dvschema "github.com/axone-protocol/axone-contract-schema/go/dataverse-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRegistry_Get(t *testing.T) {
+	tests := []struct {
+		name       string
+		lookup     string
+		factoryErr error
+		wantCalls  int32
+		wantErr    error
+	}{
+		{
+			name:      "registered dataverse",
+			lookup:    "testnet",
+			wantCalls: 1,
+		},
+		{
+			name:    "unknown dataverse",
+			lookup:  "devnet",
+			wantErr: dataverse.NewDVError(dataverse.ErrUnknownDataverse, fmt.Errorf("devnet")),
+		},
+		{
+			name:       "failing client creation retried",
+			lookup:     "testnet",
+			factoryErr: fmt.Errorf("connection refused"),
+			wantCalls:  2,
+			wantErr:    fmt.Errorf("failed to create client of dataverse testnet: connection refused"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a registry with a dataverse", t, func() {
+				var calls atomic.Int32
+				client := dataverse.NewMemoryDataverse()
+				registry := dataverse.NewRegistry()
+				So(registry.Register("testnet", func(_ context.Context) (dataverse.QueryClient, error) {
+					calls.Add(1)
+					if test.factoryErr != nil {
+						return nil, test.factoryErr
+					}
+					return client, nil
+				}), ShouldBeNil)
+
+				Convey("When the dataverse is looked up twice", func() {
+					first, err := registry.Get(context.Background(), test.lookup)
+					second, _ := registry.Get(context.Background(), test.lookup)
+
+					Convey("Then its client should be created once", func() {
+						if test.wantErr != nil {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(first, ShouldBeNil)
+						} else {
+							So(err, ShouldBeNil)
+							So(first, ShouldEqual, client)
+							So(second, ShouldEqual, client)
+						}
+						So(calls.Load(), ShouldEqual, test.wantCalls)
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	Convey("Given a registry of dataverses", t, func() {
+		server := restStandIn(map[string]any{
+			restDataverseAddr + "/dataverse": dvschema.DataverseResponse{
+				Name:               "my-dataverse",
+				TriplestoreAddress: restCognitariumAddr,
+			},
+		}, map[string]json.RawMessage{})
+		Reset(server.Close)
+
+		registry := dataverse.NewRegistry()
+		So(registry.RegisterREST("mainnet", server.URL, restDataverseAddr), ShouldBeNil)
+		So(registry.RegisterGRPC("testnet", "localhost:9090", "axone1testnet"), ShouldBeNil)
+
+		Convey("When a dataverse is registered again under the same name", func() {
+			err := registry.RegisterREST("mainnet", server.URL, "axone1other")
+
+			Convey("Then the registration should fail", func() {
+				So(err.Error(), ShouldEqual, dataverse.NewDVError(dataverse.ErrDataverseRegistered, fmt.Errorf("mainnet")).Error())
+			})
+		})
+
+		Convey("When the registered dataverses are listed", func() {
+			names := registry.Names()
+
+			Convey("Then their names should be returned in order", func() {
+				So(names, ShouldResemble, []string{"mainnet", "testnet"})
+			})
+		})
+
+		Convey("When a dataverse is looked up concurrently", func() {
+			clients := make([]dataverse.QueryClient, 8)
+			var wg sync.WaitGroup
+			for i := range clients {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					clients[i], _ = registry.Get(context.Background(), "mainnet")
+				}()
+			}
+			wg.Wait()
+
+			Convey("Then the same client should be returned to every caller", func() {
+				So(clients[0], ShouldNotBeNil)
+				for _, client := range clients {
+					So(client, ShouldEqual, clients[0])
+				}
+				info, err := clients[0].DataverseInfo(context.Background())
+				So(err, ShouldBeNil)
+				So(info.Name, ShouldEqual, "my-dataverse")
+			})
+		})
+	})
+}
+
+func TestRegistry_GetWhileCreating(t *testing.T) {
+	Convey("Given a registry with a dataverse whose client is being created", t, func() {
+		client := dataverse.NewMemoryDataverse()
+		started, release := make(chan struct{}), make(chan struct{})
+		registry := dataverse.NewRegistry()
+		So(registry.Register("testnet", func(_ context.Context) (dataverse.QueryClient, error) {
+			close(started)
+			<-release
+			return client, nil
+		}), ShouldBeNil)
+
+		var first dataverse.QueryClient
+		var firstErr error
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			first, firstErr = registry.Get(context.Background(), "testnet")
+		}()
+		<-started
+
+		Convey("When another caller gives up waiting for it", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			second, err := registry.Get(ctx, "testnet")
+			close(release)
+			<-done
+
+			Convey("Then only this caller should fail with its context error", func() {
+				So(err, ShouldEqual, context.Canceled)
+				So(second, ShouldBeNil)
+				So(firstErr, ShouldBeNil)
+				So(first, ShouldEqual, client)
+			})
+		})
+	})
+}
+
+func TestRegistry_Close(t *testing.T) {
+	Convey("Given a registry with a dataverse queried through gRPC", t, func() {
+		server, err := testutil.NewWasmServer(wasmResponses())
+		So(err, ShouldBeNil)
+		Reset(server.Close)
+
+		registry := dataverse.NewRegistry()
+		So(registry.RegisterGRPC("testnet", server.Addr, restDataverseAddr), ShouldBeNil)
+		So(registry.RegisterREST("mainnet", "http://localhost", restDataverseAddr), ShouldBeNil)
+
+		client, err := registry.Get(context.Background(), "testnet")
+		So(err, ShouldBeNil)
+
+		Convey("When the registry is closed", func() {
+			err := registry.Close()
+
+			Convey("Then the connection of the created client should be closed", func() {
+				So(err, ShouldBeNil)
+				_, err = client.GovCode(context.Background(), "axone1gov")
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "the client connection is closing")
+			})
+
+			Convey("Then the registry should not be usable anymore", func() {
+				wantErr := dataverse.NewDVError(dataverse.ErrRegistryClosed, nil)
+				_, err := registry.Get(context.Background(), "testnet")
+				So(err, ShouldResemble, wantErr)
+				So(registry.Register("devnet", nil), ShouldResemble, wantErr)
+			})
+		})
+	})
+}
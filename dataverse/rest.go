@@ -0,0 +1,110 @@
+package dataverse
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"google.golang.org/grpc"
+)
+
+// RESTOption is a function to configure the REST transport of a QueryClient.
+type RESTOption func(*restClient)
+
+// WithHTTPClient sets the HTTP client sending the REST requests, e.g. to configure timeouts or a proxy.
+// http.DefaultClient is used if not set.
+func WithHTTPClient(httpClient *http.Client) RESTOption {
+	return func(c *restClient) {
+		c.httpClient = httpClient
+	}
+}
+
+// NewRESTQueryClient creates a QueryClient performing the smart queries of the contracts through the REST (LCD) API
+// of a node at the given URL, e.g. "https://api.axone.xyz", instead of gRPC. It is meant for environments where only
+// HTTP is allowed.
+func NewRESTQueryClient(
+	ctx context.Context,
+	restAddr, contractAddr string,
+	opts ...RESTOption,
+) (QueryClient, error) {
+	rest := &restClient{baseURL: strings.TrimSuffix(restAddr, "/"), httpClient: http.DefaultClient}
+	for _, opt := range opts {
+		opt(rest)
+	}
+
+	return newContractQueryClient(ctx, rest, contractAddr, rest.lawStoneClient)
+}
+
+var _ smartQuerier = &restClient{}
+
+// restClient performs the smart queries of contracts through the REST API of a node.
+type restClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// restError is the body of the response of a failed REST request.
+type restError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (c *restClient) lawStoneClient(addr string) (lsschema.QueryClient, error) {
+	return &contractLawStoneClient{c, addr}, nil
+}
+
+func (c *restClient) smartQuery(ctx context.Context, contractAddr string, msg any, response any, _ ...grpc.CallOption) error {
+	queryData, err := json.Marshal(msg)
+	if err != nil {
+		return NewDVError(ErrMarshalJSON, err)
+	}
+
+	endpoint := fmt.Sprintf(
+		"%s/cosmwasm/wasm/v1/contract/%s/smart/%s",
+		c.baseURL,
+		url.PathEscape(contractAddr),
+		base64.URLEncoding.EncodeToString(queryData),
+	)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to query contract %s: %w", contractAddr, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var restErr restError
+		if err := json.Unmarshal(body, &restErr); err != nil || restErr.Message == "" {
+			return fmt.Errorf("failed to query contract %s: %s", contractAddr, resp.Status)
+		}
+		return fmt.Errorf("failed to query contract %s: code %d: %s", contractAddr, restErr.Code, restErr.Message)
+	}
+
+	var result struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if err := json.Unmarshal(result.Data, response); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package dataverse_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+	dvschema "github.com/axone-protocol/axone-contract-schema/go/dataverse-schema/v6"
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const (
+	restDataverseAddr   = "axone1dataverse"
+	restCognitariumAddr = "axone1cognitarium"
+)
+
+// restStandIn answers the smart queries of the REST API of a node with the responses given by contract address and
+// query name, e.g. "axone1dataverse/dataverse". Queries without response fail as the node does for an unknown
+// contract. The received queries are recorded by contract address and query name.
+func restStandIn(responses map[string]any, queries map[string]json.RawMessage) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, ok := strings.CutPrefix(r.URL.Path, "/cosmwasm/wasm/v1/contract/")
+		addr, data, found := strings.Cut(path, "/smart/")
+		if !ok || !found {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+
+		raw, err := base64.URLEncoding.DecodeString(data)
+		var msg map[string]json.RawMessage
+		if err == nil {
+			err = json.Unmarshal(raw, &msg)
+		}
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintf(w, `{"code":3,"message":%q}`, err.Error())
+			return
+		}
+
+		for name, query := range msg {
+			queries[addr+"/"+name] = query
+			response, ok := responses[addr+"/"+name]
+			if !ok {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = fmt.Fprintf(w, `{"code":2,"message":"no such contract: address %s: unknown request"}`, addr)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": response})
+		}
+	}))
+}
+
+func TestNewRESTQueryClient(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses map[string]any
+		wantErr   error
+	}{
+		{
+			name: "dataverse contract queried",
+			responses: map[string]any{
+				restDataverseAddr + "/dataverse": dvschema.DataverseResponse{
+					Name:               "my-dataverse",
+					TriplestoreAddress: restCognitariumAddr,
+				},
+			},
+		},
+		{
+			name:      "unknown dataverse contract",
+			responses: map[string]any{},
+			wantErr: fmt.Errorf(
+				"failed to get cognitarium address: failed to query contract %s: code 2: no such contract: address %s: unknown request",
+				restDataverseAddr,
+				restDataverseAddr,
+			),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a REST API of a node", t, func() {
+				queries := map[string]json.RawMessage{}
+				server := restStandIn(test.responses, queries)
+				Reset(server.Close)
+
+				Convey("When a REST query client is created", func() {
+					client, err := dataverse.NewRESTQueryClient(context.Background(), server.URL+"/", restDataverseAddr)
+
+					Convey("Then the dataverse should be described", func() {
+						if test.wantErr != nil {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							return
+						}
+						So(err, ShouldBeNil)
+						info, err := client.DataverseInfo(context.Background())
+						So(err, ShouldBeNil)
+						So(info, ShouldResemble, &dataverse.Info{Address: restDataverseAddr, Name: "my-dataverse"})
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestRESTQueryClient(t *testing.T) {
+	Convey("Given a REST query client on a node", t, func() {
+		queries := map[string]json.RawMessage{}
+		server := restStandIn(map[string]any{
+			restDataverseAddr + "/dataverse": dvschema.DataverseResponse{
+				Name:               "my-dataverse",
+				TriplestoreAddress: restCognitariumAddr,
+			},
+			restCognitariumAddr + "/store": cgschema.StoreResponse{
+				Owner: "axone1owner",
+				Stat:  cgschema.StoreStat{ByteSize: "1024", NamespaceCount: "3", TripleCount: "42"},
+			},
+			// Select responses are given raw, values not being marshalable.
+			restCognitariumAddr + "/select": json.RawMessage(`{
+				"head": {"vars": ["code"]},
+				"results": {"bindings": [{"code": {"type": "uri", "value": {"full": "contract:law-stone:axone1gov"}}}]}
+			}`),
+			"axone1gov/ask": lsschema.AskResponse{
+				Answer: &lsschema.Answer{
+					Variables: []string{"Result", "Evidence"},
+					Results: []lsschema.Result{{Substitutions: []lsschema.Substitution{
+						{Variable: "Result", Expression: "permitted"},
+						{Variable: "Evidence", Expression: "[]"},
+					}}},
+				},
+			},
+			"axone1gov/program_code": base64.StdEncoding.EncodeToString([]byte("tell(_, read, permitted, []).")),
+		}, queries)
+		Reset(server.Close)
+
+		client, err := dataverse.NewRESTQueryClient(context.Background(), server.URL, restDataverseAddr)
+		So(err, ShouldBeNil)
+
+		Convey("When the cognitarium is described", func() {
+			info, err := client.CognitariumInfo(context.Background())
+
+			Convey("Then its store should be queried", func() {
+				So(err, ShouldBeNil)
+				So(info, ShouldResemble, &dataverse.CognitariumInfo{
+					Address: restCognitariumAddr,
+					Owner:   "axone1owner",
+					Stat:    dataverse.CognitariumStat{ByteSize: "1024", NamespaceCount: "3", TripleCount: "42"},
+				})
+			})
+		})
+
+		Convey("When the governance of a resource is resolved and asked", func() {
+			addr, errAddr := client.GetResourceGovAddr(context.Background(), "did:key:resource")
+			permitted, errTell := client.AskGovTellAction(context.Background(), addr, "did:key:user", "read")
+			code, errCode := client.GovCode(context.Background(), addr)
+
+			Convey("Then the cognitarium and law-stone contracts should be queried", func() {
+				So(errAddr, ShouldBeNil)
+				So(addr, ShouldEqual, "axone1gov")
+				So(string(queries[restCognitariumAddr+"/select"]), ShouldContainSubstring, "did:key:resource")
+				So(errTell, ShouldBeNil)
+				So(permitted, ShouldBeTrue)
+				So(string(queries["axone1gov/ask"]), ShouldContainSubstring, "tell('did:key:user','read',Result,Evidence)")
+				So(errCode, ShouldBeNil)
+				So(code, ShouldEqual, "tell(_, read, permitted, []).")
+			})
+		})
+
+		Convey("When an unknown governance is asked", func() {
+			_, err := client.AskGovTellAction(context.Background(), "axone1unknown", "did:key:user", "read")
+
+			Convey("Then the error of the node should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "no such contract: address axone1unknown")
+			})
+		})
+	})
+}
@@ -0,0 +1,83 @@
+package dataverse
+
+import (
+	"context"
+	"fmt"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+)
+
+func (c *queryClient) FetchBindings(ctx context.Context, query cgschema.SelectQuery) ([]map[string]cgschema.Value, error) {
+	maxLimit, err := c.maxQueryLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bounded := query.Limit == nil || *query.Limit > maxLimit
+	if bounded {
+		query.Limit = ref(maxLimit)
+	}
+	response, err := c.cognitariumClient.Select(ctx, &cgschema.QueryMsg_Select{Query: query})
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := response.Results.Bindings
+	if bounded && len(bindings) >= maxLimit {
+		return nil, NewDVError(ErrQueryLimit, fmt.Errorf("more than %d bindings", maxLimit))
+	}
+
+	return bindings, nil
+}
+
+// maxQueryLimit returns the maximum number of bindings a select query on the cognitarium can return.
+func (c *queryClient) maxQueryLimit(ctx context.Context) (int, error) {
+	response, err := c.cognitariumClient.Store(ctx, &cgschema.QueryMsg_Store{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get cognitarium limits: %w", err)
+	}
+
+	return response.Limits.MaxQueryLimit, nil
+}
+
+// selectByIRIs runs the select query built for the given IRIs and the given maximum query limit, splitting the IRIs
+// in halves until the bindings of each query fit in this limit. When the bindings of a single IRI do not fit, the
+// error given by tooMany is returned, unless nil in which case its truncated bindings are kept.
+func (c *queryClient) selectByIRIs(
+	ctx context.Context,
+	iris []string,
+	maxLimit int,
+	build func(iris []string, limit int) cgschema.SelectQuery,
+	tooMany func(iri string) error,
+) ([]map[string]cgschema.Value, error) {
+	if len(iris) == 0 {
+		return nil, nil
+	}
+
+	response, err := c.cognitariumClient.Select(ctx, &cgschema.QueryMsg_Select{Query: build(iris, maxLimit)})
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := response.Results.Bindings
+	if len(bindings) < maxLimit {
+		return bindings, nil
+	}
+	if len(iris) == 1 {
+		if err := tooMany(iris[0]); err != nil {
+			return nil, err
+		}
+		return bindings, nil
+	}
+
+	head, err := c.selectByIRIs(ctx, iris[:len(iris)/2], maxLimit, build, tooMany)
+	if err != nil {
+		return nil, err
+	}
+	tail, err := c.selectByIRIs(ctx, iris[len(iris)/2:], maxLimit, build, tooMany)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(head, tail...), nil
+}
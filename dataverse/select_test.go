@@ -0,0 +1,174 @@
+package dataverse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+var selectedResources = []string{"did:key:a", "did:key:b", "did:key:c", "did:key:d", "did:key:e"}
+
+func resourceBinding(did string) map[string]cgschema.Value {
+	return map[string]cgschema.Value{"resource": uriValue(did)}
+}
+
+// selectResources answers a select query as a cognitarium storing the selected resources would, applying the limit of
+// the query.
+func selectResources(query cgschema.SelectQuery) (*cgschema.SelectResponse, error) {
+	bindings := []map[string]cgschema.Value{}
+	for _, did := range selectedResources[:min(*query.Limit, len(selectedResources))] {
+		bindings = append(bindings, resourceBinding(did))
+	}
+	return &cgschema.SelectResponse{
+		Head:    cgschema.Head{Vars: []string{"resource"}},
+		Results: cgschema.Results{Bindings: bindings},
+	}, nil
+}
+
+func TestClient_FetchBindings(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     *int
+		maxLimit  int
+		wantLimit int
+		wantDIDs  []string
+		wantErr   error
+	}{
+		{
+			name:      "all bindings within the maximum query limit",
+			maxLimit:  30,
+			wantLimit: 30,
+			wantDIDs:  selectedResources,
+		},
+		{
+			name:      "bindings bounded by the query limit",
+			limit:     toAddress(3),
+			maxLimit:  30,
+			wantLimit: 3,
+			wantDIDs:  selectedResources[:3],
+		},
+		{
+			name:      "maximum query limit reached",
+			maxLimit:  3,
+			wantLimit: 3,
+			wantErr:   dataverse.NewDVError(dataverse.ErrQueryLimit, fmt.Errorf("more than 3 bindings")),
+		},
+		{
+			name:      "query limit above the maximum query limit",
+			limit:     toAddress(10),
+			maxLimit:  3,
+			wantLimit: 3,
+			wantErr:   dataverse.NewDVError(dataverse.ErrQueryLimit, fmt.Errorf("more than 3 bindings")),
+		},
+		{
+			name:      "query limit equal to the maximum query limit",
+			limit:     toAddress(3),
+			maxLimit:  3,
+			wantLimit: 3,
+			wantDIDs:  selectedResources[:3],
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a mocked cognitarium client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				var limit int
+				mockClient := testutil.NewMockCognitariumQueryClient(controller)
+				mockClient.EXPECT().
+					Store(gomock.Any(), gomock.Any()).
+					Return(&cgschema.StoreResponse{Limits: cgschema.StoreLimits{MaxQueryLimit: test.maxLimit}}, nil).
+					Times(1)
+				mockClient.EXPECT().
+					Select(gomock.Any(), gomock.Any()).
+					DoAndReturn(
+						func(_ context.Context, req *cgschema.QueryMsg_Select, _ ...grpc.CallOption) (*cgschema.SelectResponse, error) {
+							limit = *req.Query.Limit
+							return selectResources(req.Query)
+						},
+					).
+					Times(1)
+
+				client := dataverse.NewDataverseQueryClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					mockClient,
+					nil,
+				)
+
+				Convey("When FetchBindings is called", func() {
+					bindings, err := client.FetchBindings(context.Background(), cgschema.SelectQuery{Limit: test.limit})
+
+					Convey("Then the bindings should be fetched in a single bounded query", func() {
+						So(limit, ShouldEqual, test.wantLimit)
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							dids := []string{}
+							for _, binding := range bindings {
+								dids = append(dids, string(*binding["resource"].ValueType.(cgschema.URI).Value.Full))
+							}
+							So(dids, ShouldResemble, test.wantDIDs)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(bindings, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestClient_FetchBindingsErrors(t *testing.T) {
+	Convey("Given a mocked cognitarium client", t, func() {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		mockClient := testutil.NewMockCognitariumQueryClient(controller)
+		client := dataverse.NewDataverseQueryClient(
+			testutil.NewMockDataverseQueryClient(controller),
+			mockClient,
+			nil,
+		)
+
+		Convey("When the cognitarium returns an error", func() {
+			mockClient.EXPECT().
+				Store(gomock.Any(), gomock.Any()).
+				Return(&cgschema.StoreResponse{Limits: cgschema.StoreLimits{MaxQueryLimit: 30}}, nil).
+				Times(1)
+			mockClient.EXPECT().
+				Select(gomock.Any(), gomock.Any()).
+				Return(nil, fmt.Errorf("error")).
+				Times(1)
+
+			bindings, err := client.FetchBindings(context.Background(), cgschema.SelectQuery{})
+
+			Convey("Then the error should be returned", func() {
+				So(err.Error(), ShouldEqual, "error")
+				So(bindings, ShouldBeNil)
+			})
+		})
+
+		Convey("When the cognitarium limits cannot be retrieved", func() {
+			mockClient.EXPECT().
+				Store(gomock.Any(), gomock.Any()).
+				Return(nil, fmt.Errorf("error")).
+				Times(1)
+
+			bindings, err := client.FetchBindings(context.Background(), cgschema.SelectQuery{})
+
+			Convey("Then the error should be returned without selecting", func() {
+				So(err.Error(), ShouldEqual, "failed to get cognitarium limits: error")
+				So(bindings, ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,129 @@
+package dataverse
+
+import (
+	"github.com/axone-protocol/axone-sdk/shacl"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/piprate/json-gold/ld"
+)
+
+const (
+	credentialsNamespace = "https://www.w3.org/2018/credentials#"
+	xsdDateTime          = "http://www.w3.org/2001/XMLSchema#dateTime"
+)
+
+// ClaimShapes returns the SHACL shapes of the credentials of the Axone ontology the dataverse expects, i.e. the
+// dataset description, governance text, digital resource publication and digital service authentication credentials.
+// Each shape targets the type of the credential, so that credentials of other types are not constrained.
+func ClaimShapes() []shacl.NodeShape {
+	return []shacl.NodeShape{
+		credentialShape("dataset/description", "DatasetDescriptionCredential", []shacl.PropertyShape{
+			{Path: ontologyIRI("dataset/description", "hasTitle"), MinCount: 1, MaxCount: 1, NodeKind: shacl.NodeKindLiteral},
+			{Path: ontologyIRI("dataset/description", "hasDescription"), MaxCount: 1, NodeKind: shacl.NodeKindLiteral},
+			{Path: ontologyIRI("dataset/description", "hasFormat"), MaxCount: 1, NodeKind: shacl.NodeKindIRI},
+			{Path: ontologyIRI("dataset/description", "hasTopic"), MaxCount: 1, NodeKind: shacl.NodeKindIRI},
+			{Path: ontologyIRI("dataset/description", "hasTag"), NodeKind: shacl.NodeKindLiteral},
+		}),
+		credentialShape("governance/text", "GovernanceTextCredential", []shacl.PropertyShape{
+			{
+				Path:     ontologyIRI("governance/text", "isGovernedBy"),
+				MinCount: 1,
+				MaxCount: 1,
+				NodeKind: shacl.NodeKindBlankNodeOrIRI,
+				Class:    ontologyIRI("governance/text", "GovernanceText"),
+				Node: &shacl.NodeShape{
+					ID: ontologyIRI("governance/text", "GovernanceTextShape"),
+					Properties: []shacl.PropertyShape{
+						{
+							Path:     ontologyIRI("governance/text", "fromGovernance"),
+							MinCount: 1,
+							MaxCount: 1,
+							NodeKind: shacl.NodeKindIRI,
+						},
+					},
+				},
+			},
+		}),
+		credentialShape("digital-resource/publication", "DigitalResourcePublicationCredential", []shacl.PropertyShape{
+			{
+				Path:     ontologyIRI("digital-resource/publication", "hasIdentifier"),
+				MinCount: 1,
+				MaxCount: 1,
+				NodeKind: shacl.NodeKindIRI,
+			},
+			{
+				Path:     ontologyIRI("digital-resource/publication", "servedBy"),
+				MinCount: 1,
+				MaxCount: 1,
+				NodeKind: shacl.NodeKindIRI,
+			},
+		}),
+		credentialShape("digital-service/authentication", "DigitalServiceAuthenticationCredential", []shacl.PropertyShape{
+			{
+				Path:     ontologyIRI("digital-service/authentication", "toService"),
+				MinCount: 1,
+				MaxCount: 1,
+				NodeKind: shacl.NodeKindIRI,
+			},
+		}),
+	}
+}
+
+// ValidateClaims validates the claims of a verifiable credential, as computed by CanonicalClaims, against the given
+// SHACL shapes, or the ClaimShapes if none is given. The JSON-LD contexts of the credential are resolved with the
+// given document loader, or the default one if nil.
+func ValidateClaims(
+	vc *verifiable.Credential,
+	documentLoader ld.DocumentLoader,
+	shapes ...shacl.NodeShape,
+) (*shacl.Report, error) {
+	claims, err := CanonicalClaims(vc, documentLoader)
+	if err != nil {
+		return nil, err
+	}
+
+	return validateClaims(claims, shapes...)
+}
+
+func validateClaims(claims []byte, shapes ...shacl.NodeShape) (*shacl.Report, error) {
+	if len(shapes) == 0 {
+		shapes = ClaimShapes()
+	}
+
+	graph, err := shacl.ParseNQuads(string(claims))
+	if err != nil {
+		return nil, NewDVError(ErrConvertRDF, err)
+	}
+
+	report, err := shacl.Validate(graph, shapes...)
+	if err != nil {
+		return nil, NewDVError(ErrInvalidShape, err)
+	}
+	return report, nil
+}
+
+// credentialShape returns the shape of a verifiable credential of the given type of the Axone ontology, whose single
+// subject must conform to the given property shapes.
+func credentialShape(schema, credentialType string, subject []shacl.PropertyShape) shacl.NodeShape {
+	return shacl.NodeShape{
+		ID:          ontologyIRI(schema, credentialType+"Shape"),
+		TargetClass: ontologyIRI(schema, credentialType),
+		Properties: []shacl.PropertyShape{
+			{
+				Path:     credentialsNamespace + "credentialSubject",
+				MinCount: 1,
+				MaxCount: 1,
+				NodeKind: shacl.NodeKindIRI,
+				Node: &shacl.NodeShape{
+					ID:         ontologyIRI(schema, credentialType+"SubjectShape"),
+					Properties: subject,
+				},
+			},
+			{Path: credentialsNamespace + "issuer", MinCount: 1, MaxCount: 1, NodeKind: shacl.NodeKindIRI},
+			{Path: credentialsNamespace + "issuanceDate", MinCount: 1, MaxCount: 1, Datatype: xsdDateTime},
+		},
+	}
+}
+
+func ontologyIRI(schema, name string) string {
+	return W3IDPrefix + "/schema/credential/" + schema + "/" + name
+}
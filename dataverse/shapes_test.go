@@ -0,0 +1,143 @@
+package dataverse_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/axone-protocol/axone-sdk/credential"
+	"github.com/axone-protocol/axone-sdk/credential/template"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/shacl"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidateClaims(t *testing.T) {
+	tests := []struct {
+		name        string
+		descriptor  credential.Descriptor
+		shapes      []shacl.NodeShape
+		wantResults int
+		wantMessage string
+	}{
+		{
+			name:       "conforming governance credential",
+			descriptor: template.NewGovernance("did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5", "contract:law-stone:addr"),
+		},
+		{
+			name:        "governance credential with a relative governance address",
+			descriptor:  template.NewGovernance("did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5", "addr"),
+			wantResults: 1,
+			wantMessage: "does not conform to shape " + dataverse.W3IDPrefix +
+				"/schema/credential/governance/text/GovernanceTextShape",
+		},
+		{
+			name:       "conforming dataset credential",
+			descriptor: template.NewDataset("did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5", "title"),
+		},
+		{
+			name:        "dataset credential with a relative dataset DID",
+			descriptor:  template.NewDataset("dataset", "title"),
+			wantResults: 2,
+			wantMessage: "<https://www.w3.org/2018/credentials#credentialSubject>: expected at least 1 value(s), got 0",
+		},
+		{
+			name: "conforming publication credential",
+			descriptor: template.NewPublication(
+				"did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+				"https://example.org/dataset",
+				"did:key:zQ3shhCAzQcroi4RqZ48eNudKWf75Fvv9ryJsxbaWCCPsfnFj",
+			),
+		},
+		{
+			name: "publication credential with a relative dataset URI",
+			descriptor: template.NewPublication(
+				"did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5",
+				"dataset",
+				"did:key:zQ3shhCAzQcroi4RqZ48eNudKWf75Fvv9ryJsxbaWCCPsfnFj",
+			),
+			wantResults: 1,
+			wantMessage: "does not conform to shape " + dataverse.W3IDPrefix +
+				"/schema/credential/digital-resource/publication/DigitalResourcePublicationCredentialSubjectShape",
+		},
+		{
+			name:       "conforming service authentication credential",
+			descriptor: authenticationDescriptor(`"did:key:zQ3shZxyDoD3QorxHJrFS68EjzDgQZSqZcj3wQqc1ngbF1vgz"`),
+		},
+		{
+			name:        "service authentication credential with a literal service",
+			descriptor:  authenticationDescriptor("10"),
+			wantResults: 1,
+			wantMessage: "value 10 is not of node kind http://www.w3.org/ns/shacl#IRI",
+		},
+		{
+			name:       "custom shapes",
+			descriptor: template.NewGovernance("did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5", "contract:law-stone:addr"),
+			shapes: []shacl.NodeShape{{
+				ID:          "https://example.org/IssuerShape",
+				TargetClass: "https://www.w3.org/2018/credentials#VerifiableCredential",
+				Properties: []shacl.PropertyShape{
+					{Path: "https://www.w3.org/2018/credentials#issuer", Pattern: "^did:example:"},
+				},
+			}},
+			wantResults: 1,
+			wantMessage: "does not match pattern ^did:example:",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a credential", t, func() {
+				loader, err := testutil.MockDocumentLoader()
+				So(err, ShouldBeNil)
+				vc, err := credential.New(test.descriptor, credential.WithParser(credential.NewDefaultParser(loader))).Generate()
+				So(err, ShouldBeNil)
+
+				Convey("When its claims are validated", func() {
+					report, err := dataverse.ValidateClaims(vc, loader, test.shapes...)
+
+					Convey("Then the expected report should be returned", func() {
+						So(err, ShouldBeNil)
+						So(report.Results, ShouldHaveLength, test.wantResults)
+						So(report.Conforms, ShouldEqual, test.wantResults == 0)
+						if test.wantMessage != "" {
+							So(report.Err().Error(), ShouldContainSubstring, test.wantMessage)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+// authenticationDescriptor describes a service authentication credential to the given service, given as a JSON value,
+// the credential templates having none.
+type authenticationDescriptor string
+
+func (d authenticationDescriptor) IssuedAt() *time.Time {
+	t := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func (d authenticationDescriptor) ProofPurpose() string {
+	return "authentication"
+}
+
+func (d authenticationDescriptor) Generate() (*bytes.Buffer, error) {
+	return bytes.NewBufferString(fmt.Sprintf(`{
+    "@context": [
+        "https://www.w3.org/2018/credentials/v1",
+        "%[1]s/schema/credential/digital-service/authentication/"
+    ],
+    "type": ["VerifiableCredential", "DigitalServiceAuthenticationCredential"],
+    "id": "%[1]s/schema/credential/digital-service/authentication/72cab400-5bd6-4eb4-8605-a5ee8c1a45c9",
+    "credentialSubject": {
+        "id": "did:key:zQ3shhCAzQcroi4RqZ48eNudKWf75Fvv9ryJsxbaWCCPsfnFj",
+        "toService": %[2]s
+    },
+    "issuanceDate": "2024-01-01T00:00:00Z",
+    "issuer": "did:key:zQ3shhCAzQcroi4RqZ48eNudKWf75Fvv9ryJsxbaWCCPsfnFj"
+}`, dataverse.W3IDPrefix, string(d))), nil
+}
@@ -0,0 +1,278 @@
+package dataverse
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axoned/v10/x/logic/interpreter"
+	"github.com/axone-protocol/axoned/v10/x/logic/interpreter/bootstrap"
+	logictypes "github.com/axone-protocol/axoned/v10/x/logic/types"
+	"github.com/axone-protocol/axoned/v10/x/logic/util"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ichiban/prolog"
+	"github.com/ichiban/prolog/engine"
+	"google.golang.org/grpc"
+)
+
+const simulatedGovAddr = "simulator"
+
+// GovSimulator evaluates a governance program locally, without any chain, using the same Prolog interpreter,
+// predicates and bootstrap as the logic module of the chain. It answers governance queries the same way a deployed
+// law-stone contract would, so that governance programs can be tested before being deployed.
+//
+// The simulated chain has no account: predicates depending on its state, such as bank_balances/2, see no balance. The
+// block information is the one given by the simulator options.
+type GovSimulator struct {
+	program string
+	limit   uint64
+	block   sdk.Context
+	client  *queryClient
+}
+
+// SimulatorOption is a function to configure a GovSimulator.
+type SimulatorOption func(*GovSimulator)
+
+// WithSolutionsLimit sets the maximum number of solutions returned for a query. Defaults to 1.
+func WithSolutionsLimit(limit uint64) SimulatorOption {
+	return func(s *GovSimulator) {
+		s.limit = limit
+	}
+}
+
+// WithBlock sets the chain ID, block height and block time seen by the governance program through the chain_id/1,
+// block_height/1 and block_time/1 predicates.
+func WithBlock(chainID string, height int64, blockTime time.Time) SimulatorOption {
+	return func(s *GovSimulator) {
+		s.block = s.block.WithChainID(chainID).WithBlockHeight(height).WithBlockTime(blockTime)
+	}
+}
+
+// NewGovSimulator creates a GovSimulator evaluating the given Prolog program. The program is compiled once to
+// report any error it may contain.
+func NewGovSimulator(ctx context.Context, program string, opts ...SimulatorOption) (*GovSimulator, error) {
+	s := &GovSimulator{
+		program: program,
+		limit:   1,
+		block:   sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter()),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.client = &queryClient{
+		lawStoneFactory: func(_ string) (lsschema.QueryClient, error) {
+			return &simulatedLawStone{s}, nil
+		},
+	}
+
+	if _, err := s.newInterpreter(ctx); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// NewGovSimulatorFromFile creates a GovSimulator evaluating the Prolog program of the given file.
+func NewGovSimulatorFromFile(ctx context.Context, path string, opts ...SimulatorOption) (*GovSimulator, error) {
+	program, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read governance program: %w", err)
+	}
+
+	return NewGovSimulator(ctx, string(program), opts...)
+}
+
+// NewGovSimulatorFromGov creates a GovSimulator evaluating the program of the governance deployed at the given
+// address, as returned by GovCode.
+func NewGovSimulatorFromGov(
+	ctx context.Context,
+	client QueryClient,
+	addr string,
+	opts ...SimulatorOption,
+) (*GovSimulator, error) {
+	program, err := client.GovCode(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewGovSimulator(ctx, program, opts...)
+}
+
+// Program returns the Prolog program evaluated by the simulator.
+func (s *GovSimulator) Program() string {
+	return s.program
+}
+
+// AskGov asks a Prolog query to the simulated governance, as QueryClient.AskGov does to a deployed one.
+func (s *GovSimulator) AskGov(ctx context.Context, query string) (*GovAnswer, error) {
+	return s.client.AskGov(ctx, simulatedGovAddr, query)
+}
+
+// AskGovPermittedActions returns the actions the simulated governance permits for the given DID, as
+// QueryClient.AskGovPermittedActions does.
+func (s *GovSimulator) AskGovPermittedActions(ctx context.Context, did string) ([]string, error) {
+	return s.client.AskGovPermittedActions(ctx, simulatedGovAddr, did)
+}
+
+// AskGovTellAction tells if the simulated governance permits the given action for the given DID, as
+// QueryClient.AskGovTellAction does.
+func (s *GovSimulator) AskGovTellAction(ctx context.Context, did, action string) (bool, error) {
+	return s.client.AskGovTellAction(ctx, simulatedGovAddr, did, action)
+}
+
+// AskGovDecision returns the decision of the simulated governance about the given action for the given DID, as
+// QueryClient.AskGovDecision does. The governance address of the decision is left empty.
+func (s *GovSimulator) AskGovDecision(ctx context.Context, did, action string) (*Decision, error) {
+	decision, err := s.client.AskGovDecision(ctx, simulatedGovAddr, did, action)
+	if decision != nil {
+		decision.GovAddr = ""
+	}
+	return decision, err
+}
+
+// LawStone returns a law-stone contract client backed by the simulator, e.g. to be returned by a LawStoneFactory.
+func (s *GovSimulator) LawStone() lsschema.QueryClient {
+	return &simulatedLawStone{s}
+}
+
+// newInterpreter creates an interpreter configured as the one of the logic module, with the program compiled.
+func (s *GovSimulator) newInterpreter(ctx context.Context) (*prolog.Interpreter, error) {
+	sdkCtx := s.sdkContext(ctx)
+	i, err := interpreter.New(
+		interpreter.WithPredicates(sdkCtx, interpreter.RegistryNames, noHook),
+		interpreter.WithBootstrap(sdkCtx, bootstrap.Bootstrap()),
+		interpreter.WithFS(emptyFS{}),
+	)
+	if err != nil {
+		return nil, NewDVError(ErrCompileProgram, err)
+	}
+
+	if err := i.ExecContext(sdkCtx, s.program); err != nil {
+		return nil, NewDVError(ErrCompileProgram, err)
+	}
+
+	return i, nil
+}
+
+// sdkContext returns the context of the simulated chain, with its block information and keepers.
+func (s *GovSimulator) sdkContext(ctx context.Context) sdk.Context {
+	return s.block.WithContext(ctx).WithValue(logictypes.BankKeeperContextKey, emptyBankKeeper{})
+}
+
+func (s *GovSimulator) ask(ctx context.Context, query string) (*logictypes.Answer, error) {
+	i, err := s.newInterpreter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.QueryInterpreter(s.sdkContext(ctx), i, query, sdkmath.NewUint(s.limit))
+}
+
+var _ lsschema.QueryClient = &simulatedLawStone{}
+
+// simulatedLawStone is a law-stone contract client answering from a GovSimulator.
+type simulatedLawStone struct {
+	simulator *GovSimulator
+}
+
+func (l *simulatedLawStone) Ask(
+	ctx context.Context,
+	req *lsschema.QueryMsg_Ask,
+	_ ...grpc.CallOption,
+) (*lsschema.AskResponse, error) {
+	answer, err := l.simulator.ask(ctx, req.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]lsschema.Result, 0, len(answer.Results))
+	for _, r := range answer.Results {
+		substitutions := make([]lsschema.Substitution, 0, len(r.Substitutions))
+		for _, sub := range r.Substitutions {
+			substitutions = append(substitutions, lsschema.Substitution{Variable: sub.Variable, Expression: sub.Expression})
+		}
+
+		result := lsschema.Result{Substitutions: substitutions}
+		if r.Error != "" {
+			result.Error = &r.Error
+		}
+		results = append(results, result)
+	}
+
+	return &lsschema.AskResponse{
+		Answer: &lsschema.Answer{
+			HasMore:   answer.HasMore,
+			Variables: answer.Variables,
+			Results:   results,
+		},
+	}, nil
+}
+
+// Program returns the identifier the program would have once stored in an objectarium contract, i.e. its SHA-256
+// hash; the simulated program having no storage, the storage address is left empty.
+func (l *simulatedLawStone) Program(
+	_ context.Context,
+	_ *lsschema.QueryMsg_Program,
+	_ ...grpc.CallOption,
+) (*lsschema.ProgramResponse, error) {
+	hash := sha256.Sum256([]byte(l.simulator.program))
+	return &lsschema.ProgramResponse{ObjectId: hex.EncodeToString(hash[:])}, nil
+}
+
+func (l *simulatedLawStone) ProgramCode(
+	_ context.Context,
+	_ *lsschema.QueryMsg_ProgramCode,
+	_ ...grpc.CallOption,
+) (*string, error) {
+	code := base64.StdEncoding.EncodeToString([]byte(l.simulator.program))
+	return &code, nil
+}
+
+// noHook lets all the predicates be executed without any gas or permission check.
+func noHook(_ string) func(*engine.Env) error {
+	return func(_ *engine.Env) error {
+		return nil
+	}
+}
+
+var _ logictypes.BankKeeper = emptyBankKeeper{}
+
+// emptyBankKeeper is the bank keeper of a chain without any account, queried by the bank predicates.
+type emptyBankKeeper struct{}
+
+func (emptyBankKeeper) GetBalance(_ context.Context, _ sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, sdkmath.ZeroInt())
+}
+
+func (emptyBankKeeper) GetAllBalances(_ context.Context, _ sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins()
+}
+
+func (emptyBankKeeper) GetAccountsBalances(_ context.Context) []banktypes.Balance {
+	return nil
+}
+
+func (emptyBankKeeper) SpendableCoins(_ context.Context, _ sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins()
+}
+
+func (emptyBankKeeper) LockedCoins(_ context.Context, _ sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins()
+}
+
+// emptyFS is a file system without any file, the simulated program having no access to the objectarium or any
+// other storage.
+type emptyFS struct{}
+
+func (emptyFS) Open(name string) (fs.File, error) {
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
@@ -0,0 +1,243 @@
+package dataverse_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/prolog"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	ownerDID = "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5"
+	otherDID = "did:key:zQ3shpoUHzwcgdt2gxjqHHnJnNkBVd4uX3ZBhmPiM7J93yqCr"
+
+	govProgram = `
+owner('did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5').
+
+action(read).
+action(write).
+
+tell(Who, Action, permitted, []) :- owner(Who), action(Action), !.
+tell(_, read, permitted, []) :- block_height(H), H < 100, !.
+tell(_, Action, prohibited, [cause('not the owner', Action)]).
+
+tell_permitted_actions(Who, Actions) :- findall(Action, (action(Action), tell(Who, Action, permitted, _)), Actions).
+`
+)
+
+func TestGovSimulator(t *testing.T) {
+	tests := []struct {
+		name          string
+		height        int64
+		did           string
+		action        string
+		wantDecision  *dataverse.Decision
+		wantPermitted []string
+	}{
+		{
+			name:   "owner permitted to write",
+			height: 200,
+			did:    ownerDID,
+			action: "write",
+			wantDecision: &dataverse.Decision{
+				DID:      ownerDID,
+				Action:   "write",
+				Result:   prolog.Atom("permitted"),
+				Evidence: prolog.List{},
+			},
+			wantPermitted: []string{"read", "write"},
+		},
+		{
+			name:   "other prohibited to write",
+			height: 50,
+			did:    otherDID,
+			action: "write",
+			wantDecision: &dataverse.Decision{
+				DID:    otherDID,
+				Action: "write",
+				Result: prolog.Atom("prohibited"),
+				Evidence: prolog.List{Elements: []prolog.Term{
+					prolog.Compound{Functor: "cause", Args: []prolog.Term{prolog.Atom("not the owner"), prolog.Atom("write")}},
+				}},
+			},
+			wantPermitted: []string{"read"},
+		},
+		{
+			name:   "other prohibited to read after block 100",
+			height: 200,
+			did:    otherDID,
+			action: "read",
+			wantDecision: &dataverse.Decision{
+				DID:    otherDID,
+				Action: "read",
+				Result: prolog.Atom("prohibited"),
+				Evidence: prolog.List{Elements: []prolog.Term{
+					prolog.Compound{Functor: "cause", Args: []prolog.Term{prolog.Atom("not the owner"), prolog.Atom("read")}},
+				}},
+			},
+			wantPermitted: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a governance simulator", t, func() {
+				simulator, err := dataverse.NewGovSimulator(
+					context.Background(),
+					govProgram,
+					dataverse.WithBlock("axone-localnet", test.height, time.Unix(1700000000, 0)),
+				)
+				So(err, ShouldBeNil)
+
+				Convey("When the governance is asked about an action", func() {
+					decision, errDecision := simulator.AskGovDecision(context.Background(), test.did, test.action)
+					permitted, errTell := simulator.AskGovTellAction(context.Background(), test.did, test.action)
+					actions, errActions := simulator.AskGovPermittedActions(context.Background(), test.did)
+
+					Convey("Then it should answer as the program decides", func() {
+						So(errDecision, ShouldBeNil)
+						So(errTell, ShouldBeNil)
+						So(errActions, ShouldBeNil)
+						So(decision, ShouldResemble, test.wantDecision)
+						So(permitted, ShouldEqual, test.wantDecision.Permitted())
+						So(actions, ShouldResemble, test.wantPermitted)
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestGovSimulator_AskGov(t *testing.T) {
+	Convey("Given a governance simulator", t, func() {
+		simulator, err := dataverse.NewGovSimulator(
+			context.Background(),
+			govProgram,
+			dataverse.WithSolutionsLimit(2),
+			dataverse.WithBlock("axone-localnet", 42, time.Unix(1700000000, 0)),
+		)
+		So(err, ShouldBeNil)
+
+		Convey("When queries are asked", func() {
+			actions, err1 := simulator.AskGov(context.Background(), "action(X).")
+			height, err2 := simulator.AskGov(context.Background(), "chain_id(C), block_height(H).")
+			unknown, err3 := simulator.AskGov(context.Background(), "unknown(X).")
+			balances, err4 := simulator.AskGov(context.Background(), "bank_balances(A, B).")
+			invalid, err5 := simulator.AskGov(context.Background(), "bank_balances('axone1foo', B).")
+
+			Convey("Then the answers should be the ones of the chain", func() {
+				So(err1, ShouldBeNil)
+				So(actions, ShouldResemble, &dataverse.GovAnswer{
+					Variables: []string{"X"},
+					Results: []dataverse.GovResult{
+						{Substitutions: []dataverse.Substitution{{Variable: "X", Term: prolog.Atom("read")}}},
+						{Substitutions: []dataverse.Substitution{{Variable: "X", Term: prolog.Atom("write")}}},
+					},
+				})
+
+				So(err2, ShouldBeNil)
+				So(height.Results[0].Substitutions, ShouldResemble, []dataverse.Substitution{
+					{Variable: "C", Term: prolog.Atom("axone-localnet")},
+					{Variable: "H", Term: prolog.Integer(42)},
+				})
+
+				So(err3, ShouldBeNil)
+				So(unknown.Results[0].Error, ShouldEqual, "error(existence_error(procedure,unknown/1),root)")
+
+				So(err4, ShouldBeNil)
+				So(balances.Results, ShouldBeEmpty)
+
+				So(err5, ShouldBeNil)
+				So(invalid.Results[0].Error, ShouldStartWith, "error(resource_error(resource_module(bank)),")
+			})
+		})
+	})
+}
+
+func TestNewGovSimulator(t *testing.T) {
+	Convey("Given an invalid governance program", t, func() {
+		program := "tell(Who, Action, permitted, []) :- "
+
+		Convey("When a simulator is created", func() {
+			simulator, err := dataverse.NewGovSimulator(context.Background(), program)
+
+			Convey("Then the compilation error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "could not compile governance program: ")
+				So(simulator, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a governance program in a file", t, func() {
+		path := filepath.Join(t.TempDir(), "gov.pl")
+		So(os.WriteFile(path, []byte(govProgram), 0o600), ShouldBeNil)
+
+		Convey("When a simulator is created from the file", func() {
+			simulator, err := dataverse.NewGovSimulatorFromFile(context.Background(), path)
+
+			Convey("Then the program of the file should be evaluated", func() {
+				So(err, ShouldBeNil)
+				So(simulator.Program(), ShouldEqual, govProgram)
+
+				permitted, err := simulator.AskGovTellAction(context.Background(), ownerDID, "write")
+				So(err, ShouldBeNil)
+				So(permitted, ShouldBeTrue)
+			})
+		})
+
+		Convey("When a simulator is created from a missing file", func() {
+			simulator, err := dataverse.NewGovSimulatorFromFile(context.Background(), path+".missing")
+
+			Convey("Then an error should be returned", func() {
+				So(err.Error(), ShouldStartWith, "failed to read governance program: ")
+				So(simulator, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a deployed governance", t, func() {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		mockClient := testutil.NewMockQueryClient(controller)
+
+		Convey("When a simulator is created from the governance code", func() {
+			mockClient.EXPECT().GovCode(gomock.Any(), "axone1gov").Return(govProgram, nil).Times(1)
+
+			simulator, err := dataverse.NewGovSimulatorFromGov(context.Background(), mockClient, "axone1gov")
+
+			Convey("Then it should answer as the deployed governance", func() {
+				So(err, ShouldBeNil)
+
+				code, err := simulator.LawStone().ProgramCode(context.Background(), &lsschema.QueryMsg_ProgramCode{})
+				So(err, ShouldBeNil)
+				So(*code, ShouldNotBeEmpty)
+
+				permitted, err := simulator.AskGovTellAction(context.Background(), otherDID, "write")
+				So(err, ShouldBeNil)
+				So(permitted, ShouldBeFalse)
+			})
+		})
+
+		Convey("When the governance code cannot be retrieved", func() {
+			mockClient.EXPECT().GovCode(gomock.Any(), "axone1gov").Return("", fmt.Errorf("error")).Times(1)
+
+			simulator, err := dataverse.NewGovSimulatorFromGov(context.Background(), mockClient, "axone1gov")
+
+			Convey("Then the error should be returned", func() {
+				So(err.Error(), ShouldEqual, "error")
+				So(simulator, ShouldBeNil)
+			})
+		})
+	})
+}
@@ -2,55 +2,253 @@ package dataverse
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/axone-protocol/axone-sdk/tx"
 	"github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	"github.com/piprate/json-gold/ld"
 )
 
-func (t *txClient) SubmitClaims(ctx context.Context, vc *verifiable.Credential) (*types.TxResponse, error) {
-	rdf, err := credentialToRDF(vc)
+// SubmitOption is a function to configure the submission of claims.
+type SubmitOption func(*submitOptions)
+
+type submitOptions struct {
+	simulate bool
+	validate bool
+	hash     *string
+}
+
+// WithSimulation makes the submission a dry run: the submission transaction is simulated instead of being broadcast,
+// so that the claims are not committed. The tx client must implement tx.Simulator, as the one created by tx.NewClient.
+func WithSimulation() SubmitOption {
+	return func(o *submitOptions) {
+		o.simulate = true
+	}
+}
+
+// WithValidation validates the claims against the ClaimShapes before submitting them, so that a credential not
+// conforming to the ontology is rejected without any transaction.
+func WithValidation() SubmitOption {
+	return func(o *submitOptions) {
+		o.validate = true
+	}
+}
+
+// WithClaimsHash sets the given string to the hash of the claims submitted, as HashClaims gives, once the submission
+// succeeded, so that the claims can be identified by their content.
+func WithClaimsHash(hash *string) SubmitOption {
+	return func(o *submitOptions) {
+		o.hash = hash
+	}
+}
+
+func (t *txClient) SubmitClaims(
+	ctx context.Context,
+	vc *verifiable.Credential,
+	opts ...SubmitOption,
+) (*types.TxResponse, error) {
+	return t.submitClaims(ctx, vc, nil, opts...)
+}
+
+// submitClaims submits a verifiable credential, resolving its JSON-LD contexts with the given document loader, or the
+// default one if nil.
+func (t *txClient) submitClaims(
+	ctx context.Context,
+	vc *verifiable.Credential,
+	documentLoader ld.DocumentLoader,
+	opts ...SubmitOption,
+) (*types.TxResponse, error) {
+	options := &submitOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	claims, err := submittedClaims(vc, documentLoader, options)
 	if err != nil {
-		return nil, NewDVError(ErrConvertRDF, err)
+		return nil, err
 	}
 
-	msg, err := json.Marshal(map[string]interface{}{
+	msg := map[string]interface{}{
 		"submit_claims": map[string]interface{}{
-			"claims": base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s", rdf))),
+			"claims": base64.StdEncoding.EncodeToString(claims),
+		},
+	}
+
+	submit := t.execute
+	if options.simulate {
+		submit = t.simulate
+	}
+	resp, err := submit(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	options.setHash(claims)
+	return resp, nil
+}
+
+func (t *txClient) RevokeClaims(ctx context.Context, credentialID string) (*types.TxResponse, error) {
+	if credentialID == "" {
+		return nil, NewDVError(ErrNoIdentifier, nil)
+	}
+
+	return t.execute(ctx, map[string]interface{}{
+		"revoke_claims": map[string]interface{}{
+			"identifier": credentialID,
 		},
 	})
+}
+
+// execute sends a transaction executing the given message on the dataverse contract.
+func (t *txClient) execute(ctx context.Context, executeMsg map[string]interface{}) (*types.TxResponse, error) {
+	return t.executeContract(ctx, t.dataverseContractAddr, executeMsg)
+}
+
+// simulate simulates a transaction executing the given message on the dataverse contract, returning a transaction
+// response holding the gas wanted and used along with the emitted events.
+func (t *txClient) simulate(ctx context.Context, executeMsg map[string]interface{}) (*types.TxResponse, error) {
+	simulation, err := t.simulateContract(ctx, t.dataverseContractAddr, executeMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.TxResponse{}
+	if simulation.GasInfo != nil {
+		resp.GasWanted = int64(simulation.GasInfo.GasWanted) //nolint:gosec // gas is bounded by the block gas limit
+		resp.GasUsed = int64(simulation.GasInfo.GasUsed)     //nolint:gosec // gas is bounded by the block gas limit
+	}
+	if simulation.Result != nil {
+		resp.Events = simulation.Result.Events
+	}
+	return resp, nil
+}
+
+// executeContract sends a transaction executing the given message on the contract at the given address.
+func (t *executor) executeContract(ctx context.Context, contract string, executeMsg interface{}) (*types.TxResponse, error) {
+	transaction, err := t.executeTx(contract, executeMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.txClient.SendTx(ctx, transaction)
+	if err != nil {
+		return nil, NewDVError(ErrSendTx, err)
+	}
+
+	return resp, nil
+}
+
+// simulateContract simulates a transaction executing the given message on the contract at the given address, an error
+// being returned if the contract rejects it or if the tx client cannot simulate transactions.
+func (t *executor) simulateContract(
+	ctx context.Context,
+	contract string,
+	executeMsg interface{},
+) (*sdktx.SimulateResponse, error) {
+	transaction, err := t.executeTx(contract, executeMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	simulator, ok := t.txClient.(tx.Simulator)
+	if !ok {
+		return nil, NewDVError(ErrNoTxSimulator, nil)
+	}
+	resp, err := simulator.Simulate(ctx, transaction)
+	if err != nil {
+		return nil, NewDVError(ErrSimulateTx, err)
+	}
+
+	return resp, nil
+}
+
+// executeTx returns the transaction executing the given message on the contract at the given address.
+func (t *executor) executeTx(contract string, executeMsg interface{}) (tx.Transaction, error) {
+	msg, err := json.Marshal(executeMsg)
 	if err != nil {
 		return nil, NewDVError(ErrMarshalJSON, err)
 	}
 
 	msgExec := &wasmtypes.MsgExecuteContract{
 		Sender:   t.signer.Addr(),
-		Contract: t.dataverseContractAddr,
+		Contract: contract,
 		Msg:      msg,
 		Funds:    nil,
 	}
 
-	resp, err := t.txClient.SendTx(ctx, tx.NewTransaction(t.txConfig,
+	return tx.NewTransaction(t.txConfig,
 		tx.WithMsgs(msgExec),
 		tx.WithSigner(t.signer),
 		tx.WithGasLimit(2000000),
-	))
+	), nil
+}
+
+// CanonicalClaims returns the claims of a verifiable credential as submitted to the dataverse contract, i.e. its RDF
+// dataset canonicalized with the URDNA2015 algorithm and serialized in N-Quads. Blank node labels and statements order
+// being canonical, identical credentials give identical claims. The JSON-LD contexts of the credential are resolved
+// with the given document loader, or the default one if nil.
+func CanonicalClaims(vc *verifiable.Credential, documentLoader ld.DocumentLoader) ([]byte, error) {
+	rdf, err := credentialToRDF(vc, documentLoader)
 	if err != nil {
-		return nil, NewDVError(ErrSendTx, err)
+		return nil, NewDVError(ErrConvertRDF, err)
 	}
 
-	return resp, nil
+	nquads, ok := rdf.(string)
+	if !ok {
+		return nil, NewDVError(ErrConvertRDF, fmt.Errorf("expected N-Quads, got %T", rdf))
+	}
+	return []byte(nquads), nil
+}
+
+// submittedClaims returns the canonical claims of a verifiable credential to submit, checking they conform to the
+// ClaimShapes if the validation is asked by the given options.
+func submittedClaims(vc *verifiable.Credential, documentLoader ld.DocumentLoader, options *submitOptions) ([]byte, error) {
+	claims, err := CanonicalClaims(vc, documentLoader)
+	if err != nil {
+		return nil, err
+	}
+	if !options.validate {
+		return claims, nil
+	}
+
+	report, err := validateClaims(claims)
+	if err != nil {
+		return nil, err
+	}
+	if !report.Conforms {
+		return nil, NewDVError(ErrInvalidClaims, report.Err())
+	}
+	return claims, nil
 }
 
-func credentialToRDF(vc *verifiable.Credential) (interface{}, error) {
+// setHash sets the hash of the given submitted claims if asked by the options.
+func (o *submitOptions) setHash(claims []byte) {
+	if o.hash != nil {
+		*o.hash = HashClaims(claims)
+	}
+}
+
+// HashClaims returns the hex encoded SHA-256 hash of the given canonical claims, identifying their content.
+func HashClaims(claims []byte) string {
+	hash := sha256.Sum256(claims)
+	return hex.EncodeToString(hash[:])
+}
+
+func credentialToRDF(vc *verifiable.Credential, documentLoader ld.DocumentLoader) (interface{}, error) {
 	proc := ld.NewJsonLdProcessor()
 	options := ld.NewJsonLdOptions("")
+	options.Algorithm = ld.AlgorithmURDNA2015
 	options.Format = "application/n-quads"
+	if documentLoader != nil {
+		options.DocumentLoader = documentLoader
+	}
 
 	vcRaw, err := vc.MarshalJSON()
 	if err != nil {
@@ -63,5 +261,5 @@ func credentialToRDF(vc *verifiable.Credential) (interface{}, error) {
 		return nil, err
 	}
 
-	return proc.ToRDF(vcJSON, options)
+	return proc.Normalize(vcJSON, options)
 }
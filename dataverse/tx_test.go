@@ -2,14 +2,19 @@ package dataverse_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/axone-protocol/axone-sdk/credential"
 	"github.com/axone-protocol/axone-sdk/credential/template"
 	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/shacl"
 	"github.com/axone-protocol/axone-sdk/testutil"
 	"github.com/axone-protocol/axone-sdk/tx"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	. "github.com/smartystreets/goconvey/convey"
 	"go.uber.org/mock/gomock"
@@ -74,6 +79,329 @@ func TestClient_SubmitClaims(t *testing.T) {
 	}
 }
 
+func TestClient_SubmitClaimsSimulation(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []dataverse.SubmitOption
+		noTxSimulator bool
+		simulateErr   error
+		wantResp      *types.TxResponse
+		wantErr       error
+	}{
+		{
+			name:     "submission",
+			wantResp: &types.TxResponse{TxHash: "hash"},
+		},
+		{
+			name:     "simulated submission",
+			opts:     []dataverse.SubmitOption{dataverse.WithSimulation()},
+			wantResp: &types.TxResponse{GasWanted: 2000000, GasUsed: 150000, Events: []abci.Event{{Type: "wasm"}}},
+		},
+		{
+			name:        "simulated submission rejected by the contract",
+			opts:        []dataverse.SubmitOption{dataverse.WithSimulation()},
+			simulateErr: fmt.Errorf("failed to simulate tx: claims already submitted"),
+			wantErr: dataverse.NewDVError(dataverse.ErrSimulateTx,
+				fmt.Errorf("failed to simulate tx: claims already submitted")),
+		},
+		{
+			name:          "simulated submission without tx simulator",
+			opts:          []dataverse.SubmitOption{dataverse.WithSimulation()},
+			noTxSimulator: true,
+			wantErr:       dataverse.NewDVError(dataverse.ErrNoTxSimulator, nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+				txConfig, err := tx.MakeDefaultTxConfig()
+				So(err, ShouldBeNil)
+				loader, err := testutil.MockDocumentLoader()
+				So(err, ShouldBeNil)
+
+				mockTxClient := testutil.NewMockTxClient(controller)
+				mockTxSimulator := testutil.NewMockTxSimulator(controller)
+				mockKeyring := testutil.NewMockKeyring(controller)
+
+				var txClient tx.Client = txClientSimulator{mockTxClient, mockTxSimulator}
+				if test.noTxSimulator {
+					txClient = mockTxClient
+				}
+
+				mockKeyring.EXPECT().Addr().Return("addr").AnyTimes()
+				if len(test.opts) == 0 {
+					mockTxClient.EXPECT().SendTx(gomock.Any(), gomock.Any()).Return(test.wantResp, nil).Times(1)
+				} else if !test.noTxSimulator {
+					mockTxSimulator.EXPECT().
+						Simulate(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, transaction tx.Transaction) (*sdktx.SimulateResponse, error) {
+							So(transaction.Sender(), ShouldEqual, "addr")
+							if test.simulateErr != nil {
+								return nil, test.simulateErr
+							}
+							return &sdktx.SimulateResponse{
+								GasInfo: &types.GasInfo{GasWanted: 2000000, GasUsed: 150000},
+								Result:  &types.Result{Events: []abci.Event{{Type: "wasm"}}},
+							}, nil
+						}).
+						Times(1)
+				}
+
+				client := dataverse.NewDataverseTxClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					testutil.NewMockCognitariumQueryClient(controller),
+					nil,
+					txClient,
+					txConfig,
+					mockKeyring,
+				)
+
+				Convey("When the claims are submitted", func() {
+					r, err := dataverse.SubmitClaimsWithLoader(context.Background(), client, generateVC(), loader, test.opts...)
+
+					Convey("Then the expected submission should be returned", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(r, ShouldResemble, test.wantResp)
+						} else {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(r, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestClient_SubmitClaimsHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		sendErr  error
+		wantHash bool
+	}{
+		{
+			name:     "submission",
+			wantHash: true,
+		},
+		{
+			name:    "failed submission",
+			sendErr: fmt.Errorf("error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+				txConfig, err := tx.MakeDefaultTxConfig()
+				So(err, ShouldBeNil)
+				loader, err := testutil.MockDocumentLoader()
+				So(err, ShouldBeNil)
+
+				mockTxClient := testutil.NewMockTxClient(controller)
+				mockKeyring := testutil.NewMockKeyring(controller)
+				mockKeyring.EXPECT().Addr().Return("addr").AnyTimes()
+				mockTxClient.EXPECT().
+					SendTx(gomock.Any(), gomock.Any()).
+					Return(&types.TxResponse{TxHash: "hash"}, test.sendErr).
+					Times(1)
+
+				client := dataverse.NewDataverseTxClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					testutil.NewMockCognitariumQueryClient(controller),
+					nil,
+					mockTxClient,
+					txConfig,
+					mockKeyring,
+				)
+
+				Convey("When the claims are submitted asking for their hash", func() {
+					vc := generateVC()
+					var hash string
+					_, err := dataverse.SubmitClaimsWithLoader(context.Background(), client, vc, loader,
+						dataverse.WithClaimsHash(&hash))
+
+					Convey("Then the hash of the submitted claims should be set once submitted", func() {
+						if !test.wantHash {
+							So(err, ShouldNotBeNil)
+							So(hash, ShouldBeEmpty)
+							return
+						}
+						So(err, ShouldBeNil)
+						claims, err := dataverse.CanonicalClaims(vc, loader)
+						So(err, ShouldBeNil)
+						So(hash, ShouldEqual, dataverse.HashClaims(claims))
+					})
+				})
+			})
+		})
+	}
+}
+
+// txClientSimulator is a tx client able to simulate transactions, as the one created by tx.NewClient.
+type txClientSimulator struct {
+	*testutil.MockTxClient
+	*testutil.MockTxSimulator
+}
+
+func TestClient_SubmitClaimsValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		credential credential.Descriptor
+		opts       []dataverse.SubmitOption
+		wantErr    bool
+	}{
+		{
+			name:       "non conforming claims submitted without validation",
+			credential: template.NewGovernance("datasetID", "addr"),
+		},
+		{
+			name:       "conforming claims submitted with validation",
+			credential: template.NewGovernance("did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5", "contract:law-stone:addr"),
+			opts:       []dataverse.SubmitOption{dataverse.WithValidation()},
+		},
+		{
+			name:       "non conforming claims rejected by validation",
+			credential: template.NewGovernance("datasetID", "addr"),
+			opts:       []dataverse.SubmitOption{dataverse.WithValidation()},
+			wantErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a client and a credential", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+				txConfig, err := tx.MakeDefaultTxConfig()
+				So(err, ShouldBeNil)
+				loader, err := testutil.MockDocumentLoader()
+				So(err, ShouldBeNil)
+				vc, err := credential.New(test.credential, credential.WithParser(credential.NewDefaultParser(loader))).Generate()
+				So(err, ShouldBeNil)
+
+				mockTxClient := testutil.NewMockTxClient(controller)
+				mockKeyring := testutil.NewMockKeyring(controller)
+
+				mockKeyring.EXPECT().Addr().Return("addr").AnyTimes()
+				if !test.wantErr {
+					mockTxClient.EXPECT().SendTx(gomock.Any(), gomock.Any()).Return(&types.TxResponse{TxHash: "hash"}, nil).Times(1)
+				}
+
+				client := dataverse.NewDataverseTxClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					testutil.NewMockCognitariumQueryClient(controller),
+					nil,
+					mockTxClient,
+					txConfig,
+					mockKeyring,
+				)
+
+				Convey("When the claims are submitted", func() {
+					r, err := dataverse.SubmitClaimsWithLoader(context.Background(), client, vc, loader, test.opts...)
+
+					Convey("Then the claims should be validated only if asked", func() {
+						if !test.wantErr {
+							So(err, ShouldBeNil)
+							So(r.TxHash, ShouldEqual, "hash")
+						} else {
+							var validationErr *shacl.ValidationError
+							So(errors.As(err, &validationErr), ShouldBeTrue)
+							So(validationErr.Report.Conforms, ShouldBeFalse)
+							So(err.Error(), ShouldStartWith, string(dataverse.ErrInvalidClaims))
+							So(r, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestClient_RevokeClaims(t *testing.T) {
+	tests := []struct {
+		name         string
+		credentialID string
+		sendTxError  error
+		wantErr      error
+	}{
+		{
+			name:         "valid credential identifier",
+			credentialID: "https://w3id.org/axone/ontology/v4/schema/credential/dataset/description/id",
+			wantErr:      nil,
+		},
+		{
+			name:         "empty credential identifier",
+			credentialID: "",
+			wantErr:      dataverse.NewDVError(dataverse.ErrNoIdentifier, nil),
+		},
+		{
+			name:         "transaction error",
+			credentialID: "https://w3id.org/axone/ontology/v4/schema/credential/dataset/description/id",
+			sendTxError:  fmt.Errorf("insufficient fees"),
+			wantErr:      dataverse.NewDVError(dataverse.ErrSendTx, fmt.Errorf("insufficient fees")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+				txConfig, err := tx.MakeDefaultTxConfig()
+				So(err, ShouldBeNil)
+
+				mockTxClient := testutil.NewMockTxClient(controller)
+				mockKeyring := testutil.NewMockKeyring(controller)
+
+				mockKeyring.EXPECT().Addr().Return("addr").AnyTimes()
+				if test.credentialID != "" {
+					mockTxClient.EXPECT().
+						SendTx(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, transaction tx.Transaction) (*types.TxResponse, error) {
+							So(transaction.Sender(), ShouldEqual, "addr")
+							if test.sendTxError != nil {
+								return nil, test.sendTxError
+							}
+							return &types.TxResponse{}, nil
+						}).
+						Times(1)
+				}
+
+				client := dataverse.NewDataverseTxClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					testutil.NewMockCognitariumQueryClient(controller),
+					nil,
+					mockTxClient,
+					txConfig,
+					mockKeyring,
+				)
+
+				Convey("When RevokeClaims is called", func() {
+					r, err := client.RevokeClaims(context.Background(), test.credentialID)
+
+					Convey("Then should return expected error", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(r, ShouldNotBeNil)
+						} else {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(r, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
 func generateVC() *verifiable.Credential {
 	loader, _ := testutil.MockDocumentLoader()
 	vc, err := credential.New(
@@ -86,3 +414,25 @@ func generateVC() *verifiable.Credential {
 	}
 	return vc
 }
+
+func TestCanonicalClaims(t *testing.T) {
+	Convey("Given a credential", t, func() {
+		loader, err := testutil.MockDocumentLoader()
+		So(err, ShouldBeNil)
+		vc := generateVC()
+
+		Convey("When its canonical claims are computed twice", func() {
+			claims1, err1 := dataverse.CanonicalClaims(vc, loader)
+			claims2, err2 := dataverse.CanonicalClaims(vc, loader)
+
+			Convey("Then the claims and their hash should be identical", func() {
+				So(err1, ShouldBeNil)
+				So(err2, ShouldBeNil)
+				So(string(claims1), ShouldContainSubstring, "_:c14n0 ")
+				So(claims1, ShouldResemble, claims2)
+				So(dataverse.HashClaims(claims1), ShouldHaveLength, 64)
+				So(dataverse.HashClaims(claims1), ShouldEqual, dataverse.HashClaims(claims2))
+			})
+		})
+	})
+}
@@ -0,0 +1,41 @@
+package dataverse
+
+import (
+	"context"
+	"fmt"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+)
+
+func (c *queryClient) GetZoneMembers(ctx context.Context, zoneDID string) ([]string, error) {
+	maxLimit, err := c.maxQueryLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.cognitariumClient.Select(ctx, &cgschema.QueryMsg_Select{
+		Query: buildGetZoneMembersRequest(zoneDID, maxLimit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Results.Bindings) >= maxLimit {
+		return nil, NewDVError(ErrQueryLimit, fmt.Errorf("more than %d memberships of %s", maxLimit, zoneDID))
+	}
+
+	seen := make(map[string]struct{}, len(response.Results.Bindings))
+	members := make([]string, 0, len(response.Results.Bindings))
+	for _, binding := range response.Results.Bindings {
+		member, err := bindingIRI(binding, "member")
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[member]; ok {
+			continue
+		}
+		seen[member] = struct{}{}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
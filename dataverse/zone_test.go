@@ -0,0 +1,127 @@
+package dataverse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	cgschema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestClient_GetZoneMembers(t *testing.T) {
+	tests := []struct {
+		name          string
+		zoneDID       string
+		response      *cgschema.SelectResponse
+		responseError error
+		wantErr       error
+		wantResult    []string
+	}{
+		{
+			name:    "zone with members",
+			zoneDID: "did:key:zone",
+			response: &cgschema.SelectResponse{
+				Head: cgschema.Head{Vars: []string{"member"}},
+				Results: cgschema.Results{
+					Bindings: []map[string]cgschema.Value{
+						{"member": uriValue("did:key:dataset1")},
+						{"member": uriValue("did:key:service1")},
+						{"member": uriValue("did:key:dataset1")},
+					},
+				},
+			},
+			wantResult: []string{"did:key:dataset1", "did:key:service1"},
+		},
+		{
+			name:    "zone without members",
+			zoneDID: "did:key:zone",
+			response: &cgschema.SelectResponse{
+				Head:    cgschema.Head{Vars: []string{"member"}},
+				Results: cgschema.Results{Bindings: []map[string]cgschema.Value{}},
+			},
+			wantResult: []string{},
+		},
+		{
+			name:    "memberships exceeding the query limit",
+			zoneDID: "did:key:zone",
+			response: &cgschema.SelectResponse{
+				Results: cgschema.Results{
+					Bindings: []map[string]cgschema.Value{
+						{"member": uriValue("did:key:dataset1")},
+						{"member": uriValue("did:key:dataset2")},
+						{"member": uriValue("did:key:dataset3")},
+						{"member": uriValue("did:key:dataset4")},
+					},
+				},
+			},
+			wantErr: dataverse.NewDVError(dataverse.ErrQueryLimit, fmt.Errorf("more than 4 memberships of did:key:zone")),
+		},
+		{
+			name:          "grpc error",
+			zoneDID:       "did:key:zone",
+			responseError: fmt.Errorf("gRPC: connection refused"),
+			wantErr:       fmt.Errorf("gRPC: connection refused"),
+		},
+		{
+			name:    "invalid value type in response",
+			zoneDID: "did:key:zone",
+			response: &cgschema.SelectResponse{
+				Head: cgschema.Head{Vars: []string{"member"}},
+				Results: cgschema.Results{
+					Bindings: []map[string]cgschema.Value{
+						{"member": literalValue("did:key:dataset1")},
+					},
+				},
+			},
+			wantErr: dataverse.NewDVError(dataverse.ErrType, fmt.Errorf("expected URI, got %T", cgschema.Value_Literal{})),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a mocked cognitarium client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				mockCognitarium := testutil.NewMockCognitariumQueryClient(controller)
+				mockCognitarium.
+					EXPECT().
+					Store(gomock.Any(), gomock.Any()).
+					Return(&cgschema.StoreResponse{Limits: cgschema.StoreLimits{MaxQueryLimit: 4}}, nil).
+					Times(1)
+				mockCognitarium.
+					EXPECT().
+					Select(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *cgschema.QueryMsg_Select, _ ...any) (*cgschema.SelectResponse, error) {
+						So(*req.Query.Limit, ShouldEqual, 4)
+						return test.response, test.responseError
+					}).
+					Times(1)
+
+				client := dataverse.NewDataverseQueryClient(
+					testutil.NewMockDataverseQueryClient(controller),
+					mockCognitarium,
+					nil,
+				)
+
+				Convey("When GetZoneMembers is called", func() {
+					members, err := client.GetZoneMembers(context.Background(), test.zoneDID)
+
+					Convey("Then the members of the zone should be returned", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(members, ShouldResemble, test.wantResult)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(members, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
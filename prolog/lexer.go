@@ -0,0 +1,290 @@
+package prolog
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenAtom
+	tokenQuotedAtom
+	tokenVariable
+	tokenString
+	tokenInteger
+	tokenFloat
+	tokenPunct
+	tokenEnd
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	offset int
+	// layoutBefore tells if the token is preceded by layout text (whitespaces or comments), which distinguishes a
+	// functional notation foo(a) from an operator applied to a parenthesized term - (a).
+	layoutBefore bool
+}
+
+type lexer struct {
+	input  string
+	offset int
+}
+
+func (l *lexer) peekRune() (rune, int) {
+	if l.offset >= len(l.input) {
+		return utf8.RuneError, 0
+	}
+	return utf8.DecodeRuneInString(l.input[l.offset:])
+}
+
+func (l *lexer) skipLayout() (bool, error) {
+	start := l.offset
+	for l.offset < len(l.input) {
+		r, size := l.peekRune()
+		switch {
+		case unicode.IsSpace(r):
+			l.offset += size
+		case r == '%':
+			if i := strings.IndexByte(l.input[l.offset:], '\n'); i != -1 {
+				l.offset += i + 1
+			} else {
+				l.offset = len(l.input)
+			}
+		case strings.HasPrefix(l.input[l.offset:], "/*"):
+			i := strings.Index(l.input[l.offset+2:], "*/")
+			if i == -1 {
+				return false, l.errorf(l.offset, "unterminated block comment")
+			}
+			l.offset += i + 4
+		default:
+			return l.offset > start, nil
+		}
+	}
+	return l.offset > start, nil
+}
+
+//nolint:funlen,gocognit,cyclop
+func (l *lexer) next() (token, error) {
+	layout, err := l.skipLayout()
+	if err != nil {
+		return token{}, err
+	}
+
+	start := l.offset
+	tok := token{offset: start, layoutBefore: layout}
+	if l.offset >= len(l.input) {
+		tok.kind = tokenEOF
+		return tok, nil
+	}
+
+	r, size := l.peekRune()
+	switch {
+	case unicode.IsDigit(r):
+		return l.number(tok)
+	case r == '_' || unicode.IsUpper(r):
+		l.offset += size
+		l.consumeWhile(isAlnum)
+		tok.kind, tok.text = tokenVariable, l.input[start:l.offset]
+	case unicode.IsLetter(r):
+		l.offset += size
+		l.consumeWhile(isAlnum)
+		tok.kind, tok.text = tokenAtom, l.input[start:l.offset]
+	case r == '\'':
+		text, err := l.quoted('\'')
+		if err != nil {
+			return token{}, err
+		}
+		tok.kind, tok.text = tokenQuotedAtom, text
+	case r == '"':
+		text, err := l.quoted('"')
+		if err != nil {
+			return token{}, err
+		}
+		tok.kind, tok.text = tokenString, text
+	case strings.ContainsRune("()[]{},|", r):
+		l.offset += size
+		tok.kind, tok.text = tokenPunct, string(r)
+	case r == '!' || r == ';':
+		l.offset += size
+		tok.kind, tok.text = tokenAtom, string(r)
+	case isSymbolChar(r):
+		l.consumeWhile(isSymbolChar)
+		tok.kind, tok.text = tokenAtom, l.input[start:l.offset]
+		if tok.text == "." {
+			next, _ := l.peekRune()
+			if l.offset >= len(l.input) || unicode.IsSpace(next) || next == '%' {
+				tok.kind = tokenEnd
+			}
+		}
+	default:
+		return token{}, l.errorf(start, "unexpected character %q", r)
+	}
+
+	return tok, nil
+}
+
+func (l *lexer) consumeWhile(f func(rune) bool) {
+	for l.offset < len(l.input) {
+		r, size := l.peekRune()
+		if !f(r) {
+			return
+		}
+		l.offset += size
+	}
+}
+
+func (l *lexer) number(tok token) (token, error) {
+	start := l.offset
+	rest := l.input[l.offset:]
+
+	if len(rest) > 2 && rest[0] == '0' {
+		switch rest[1] {
+		case '\'':
+			l.offset += 2
+			r, err := l.quotedChar('\'')
+			if err != nil {
+				return token{}, err
+			}
+			tok.kind, tok.text = tokenInteger, strconv.Itoa(int(r))
+			return tok, nil
+		case 'x', 'o', 'b':
+			base := map[byte]int{'x': 16, 'o': 8, 'b': 2}[rest[1]]
+			l.offset += 2
+			digitsStart := l.offset
+			l.consumeWhile(func(r rune) bool { return unicode.Is(unicode.ASCII_Hex_Digit, r) })
+			v, ok := new(big.Int).SetString(l.input[digitsStart:l.offset], base)
+			if !ok {
+				return token{}, l.errorf(start, "invalid number %s", l.input[start:l.offset])
+			}
+			tok.kind, tok.text = tokenInteger, v.String()
+			return tok, nil
+		}
+	}
+
+	tok.kind = tokenInteger
+	l.consumeWhile(unicode.IsDigit)
+	if l.offset+1 < len(l.input) && l.input[l.offset] == '.' && isDigitByte(l.input[l.offset+1]) {
+		tok.kind = tokenFloat
+		l.offset++
+		l.consumeWhile(unicode.IsDigit)
+		if l.offset < len(l.input) && (l.input[l.offset] == 'e' || l.input[l.offset] == 'E') {
+			exp := l.offset + 1
+			if exp < len(l.input) && (l.input[exp] == '+' || l.input[exp] == '-') {
+				exp++
+			}
+			if exp < len(l.input) && isDigitByte(l.input[exp]) {
+				l.offset = exp
+				l.consumeWhile(unicode.IsDigit)
+			}
+		}
+	}
+	tok.text = l.input[start:l.offset]
+	return tok, nil
+}
+
+func (l *lexer) quoted(quote rune) (string, error) {
+	start := l.offset
+	l.offset++
+
+	var b strings.Builder
+	for {
+		if l.offset >= len(l.input) {
+			return "", l.errorf(start, "unterminated quoted")
+		}
+		r, size := l.peekRune()
+		if r == quote {
+			if next := l.offset + size; next < len(l.input) && rune(l.input[next]) == quote {
+				b.WriteRune(quote)
+				l.offset = next + 1
+				continue
+			}
+			l.offset += size
+			return b.String(), nil
+		}
+		if r == '\\' && strings.HasPrefix(l.input[l.offset:], "\\\n") {
+			l.offset += 2
+			continue
+		}
+		c, err := l.quotedChar(quote)
+		if err != nil {
+			return "", err
+		}
+		b.WriteRune(c)
+	}
+}
+
+//nolint:cyclop
+func (l *lexer) quotedChar(quote rune) (rune, error) {
+	start := l.offset
+	r, size := l.peekRune()
+	if size == 0 {
+		return 0, l.errorf(start, "unexpected end of input")
+	}
+	l.offset += size
+	if r == quote && quote == '\'' && strings.HasPrefix(l.input[l.offset:], "'") {
+		l.offset++
+		return r, nil
+	}
+	if r != '\\' {
+		return r, nil
+	}
+
+	r, size = l.peekRune()
+	if size == 0 {
+		return 0, l.errorf(start, "unexpected end of input")
+	}
+	l.offset += size
+	switch r {
+	case 'n':
+		return '\n', nil
+	case 't':
+		return '\t', nil
+	case 'r':
+		return '\r', nil
+	case 'a':
+		return '\a', nil
+	case 'b':
+		return '\b', nil
+	case 'f':
+		return '\f', nil
+	case 'v':
+		return '\v', nil
+	case '0', '1', '2', '3', '4', '5', '6', '7':
+		return l.codeEscape(start, l.offset-size, 8)
+	case 'x':
+		return l.codeEscape(start, l.offset, 16)
+	case '\\', '\'', '"', '`':
+		return r, nil
+	default:
+		return 0, l.errorf(start, "invalid escape sequence \\%c", r)
+	}
+}
+
+// codeEscape reads the digits of a character code escape sequence, which is closed by a backslash.
+func (l *lexer) codeEscape(start, digitsStart, base int) (rune, error) {
+	end := strings.IndexByte(l.input[digitsStart:], '\\')
+	if end == -1 {
+		return 0, l.errorf(start, "unterminated escape sequence")
+	}
+	code, err := strconv.ParseInt(l.input[digitsStart:digitsStart+end], base, 32)
+	if err != nil {
+		return 0, l.errorf(start, "invalid escape sequence")
+	}
+	l.offset = digitsStart + end + 1
+	return rune(code), nil
+}
+
+func (l *lexer) errorf(offset int, format string, args ...any) error {
+	return &SyntaxError{Offset: offset, Message: fmt.Sprintf(format, args...)}
+}
+
+func isDigitByte(b byte) bool {
+	return b >= '0' && b <= '9'
+}
@@ -0,0 +1,421 @@
+package prolog
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// SyntaxError is returned when a text cannot be parsed as a Prolog term.
+type SyntaxError struct {
+	// Offset is the position in bytes of the error in the parsed text.
+	Offset int
+	// Message describes the error.
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at offset %d: %s", e.Offset, e.Message)
+}
+
+type operatorType int
+
+const (
+	xfx operatorType = iota
+	xfy
+	yfx
+	fy
+	fx
+)
+
+type operator struct {
+	priority int
+	typ      operatorType
+}
+
+// infixOperators and prefixOperators are the ISO standard operators along with the ones commonly defined by
+// Prolog systems.
+//
+//nolint:gochecknoglobals
+var (
+	infixOperators = map[string]operator{
+		":-": {1200, xfx}, "-->": {1200, xfx},
+		"|":  {1105, xfy},
+		";":  {1100, xfy},
+		"->": {1050, xfy}, "*->": {1050, xfy},
+		",": {1000, xfy},
+		"=": {700, xfx}, `\=`: {700, xfx}, "==": {700, xfx}, `\==`: {700, xfx},
+		"@<": {700, xfx}, "@>": {700, xfx}, "@=<": {700, xfx}, "@>=": {700, xfx},
+		"=..": {700, xfx}, "is": {700, xfx}, "=:=": {700, xfx}, `=\=`: {700, xfx},
+		"<": {700, xfx}, ">": {700, xfx}, "=<": {700, xfx}, ">=": {700, xfx},
+		":": {200, xfy},
+		"+": {500, yfx}, "-": {500, yfx}, `/\`: {500, yfx}, `\/`: {500, yfx}, "xor": {500, yfx},
+		"*": {400, yfx}, "/": {400, yfx}, "//": {400, yfx}, "rem": {400, yfx}, "mod": {400, yfx},
+		"div": {400, yfx}, "<<": {400, yfx}, ">>": {400, yfx},
+		"**": {200, xfx}, "^": {200, xfy},
+	}
+	prefixOperators = map[string]operator{
+		":-": {1200, fx}, "?-": {1200, fx},
+		"dynamic": {1150, fx}, "discontiguous": {1150, fx}, "initialization": {1150, fx}, "multifile": {1150, fx},
+		`\+`: {900, fy},
+		"-":  {200, fy}, "+": {200, fy}, `\`: {200, fy},
+	}
+)
+
+const maxPriority = 1200
+
+// Parse parses a single Prolog term from the given text, such as the expression of a variable substitution in a
+// law-stone answer. The term may optionally be followed by an end token (i.e. a dot).
+func Parse(text string) (Term, error) {
+	p := &parser{lexer: lexer{input: text}}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	term, _, err := p.parse(maxPriority)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.tok.kind == tokenEnd {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	if p.tok.kind != tokenEOF {
+		return nil, p.unexpected()
+	}
+
+	return term, nil
+}
+
+// ParseProgram parses the clauses and directives of a Prolog program, such as the code of a law-stone contract, each
+// of them being terminated by an end token. Operators defined by the program itself are not taken into account.
+func ParseProgram(text string) ([]Term, error) {
+	p := &parser{lexer: lexer{input: text}}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	var clauses []Term
+	for p.tok.kind != tokenEOF {
+		clause, _, err := p.parse(maxPriority)
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokenEnd {
+			return nil, p.unexpected()
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		clauses = append(clauses, clause)
+	}
+
+	return clauses, nil
+}
+
+type parser struct {
+	lexer lexer
+	tok   token
+	// inArg tells if an argument or a list element is being parsed, which the comma and bar tokens end whatever
+	// their priority. Operators of a priority above 999, such as ;, are accepted in arguments as most Prolog systems
+	// do, e.g. f(a;b).
+	inArg bool
+}
+
+func (p *parser) advance() error {
+	tok, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *parser) isPunct(text string) bool {
+	return p.tok.kind == tokenPunct && p.tok.text == text
+}
+
+func (p *parser) expect(text string) error {
+	if !p.isPunct(text) {
+		return p.unexpected()
+	}
+	return p.advance()
+}
+
+func (p *parser) unexpected() error {
+	if p.tok.kind == tokenEOF {
+		return &SyntaxError{Offset: p.tok.offset, Message: "unexpected end of input"}
+	}
+	return &SyntaxError{Offset: p.tok.offset, Message: fmt.Sprintf("unexpected token %q", p.tok.text)}
+}
+
+// parse parses a term whose priority is at most maxPrio and returns it along with its priority.
+func (p *parser) parse(maxPrio int) (Term, int, error) {
+	left, leftPrio, err := p.parsePrimary(maxPrio)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for {
+		name, ok := p.infixName()
+		if !ok || p.inArg && (name == "," || name == "|") {
+			return left, leftPrio, nil
+		}
+		op := infixOperators[name]
+		if op.priority > maxPrio {
+			return left, leftPrio, nil
+		}
+
+		leftMax, rightMax := op.priority-1, op.priority-1
+		switch op.typ {
+		case yfx:
+			leftMax = op.priority
+		case xfy:
+			rightMax = op.priority
+		}
+		if leftPrio > leftMax {
+			return left, leftPrio, nil
+		}
+
+		if err := p.advance(); err != nil {
+			return nil, 0, err
+		}
+		right, _, err := p.parse(rightMax)
+		if err != nil {
+			return nil, 0, err
+		}
+		left, leftPrio = Compound{Functor: Atom(name), Args: []Term{left, right}}, op.priority
+	}
+}
+
+// infixName returns the name of the infix operator the current token stands for, if any.
+func (p *parser) infixName() (string, bool) {
+	var name string
+	switch p.tok.kind {
+	case tokenAtom:
+		name = p.tok.text
+	case tokenPunct:
+		if p.tok.text != "," && p.tok.text != "|" {
+			return "", false
+		}
+		name = p.tok.text
+	default:
+		return "", false
+	}
+	_, ok := infixOperators[name]
+	return name, ok
+}
+
+//nolint:cyclop,funlen
+func (p *parser) parsePrimary(maxPrio int) (Term, int, error) {
+	tok := p.tok
+	switch tok.kind {
+	case tokenInteger:
+		if v, err := strconv.ParseInt(tok.text, 10, 64); err == nil {
+			return Integer(v), 0, p.advance()
+		}
+		v, ok := new(big.Int).SetString(tok.text, 10)
+		if !ok {
+			return nil, 0, &SyntaxError{Offset: tok.offset, Message: fmt.Sprintf("invalid integer %s", tok.text)}
+		}
+		return BigInteger{v}, 0, p.advance()
+	case tokenFloat:
+		v, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, 0, &SyntaxError{Offset: tok.offset, Message: fmt.Sprintf("invalid float %s", tok.text)}
+		}
+		return Float(v), 0, p.advance()
+	case tokenVariable:
+		return Variable(tok.text), 0, p.advance()
+	case tokenString:
+		return String(tok.text), 0, p.advance()
+	case tokenQuotedAtom:
+		if err := p.advance(); err != nil {
+			return nil, 0, err
+		}
+		return p.parseAtomOrCompound(Atom(tok.text))
+	case tokenAtom:
+		if err := p.advance(); err != nil {
+			return nil, 0, err
+		}
+		if p.isPunct("(") && !p.tok.layoutBefore {
+			return p.parseAtomOrCompound(Atom(tok.text))
+		}
+		return p.parsePrefix(tok, maxPrio)
+	case tokenPunct:
+		switch tok.text {
+		case "(":
+			if err := p.advance(); err != nil {
+				return nil, 0, err
+			}
+			term, err := p.parseNested()
+			if err != nil {
+				return nil, 0, err
+			}
+			return term, 0, p.expect(")")
+		case "[":
+			return p.parseList()
+		case "{":
+			return p.parseCurly()
+		}
+	}
+
+	return nil, 0, p.unexpected()
+}
+
+// parseAtomOrCompound parses the arguments of a compound term if the given atom is immediately followed by an opening
+// parenthesis.
+func (p *parser) parseAtomOrCompound(name Atom) (Term, int, error) {
+	if !p.isPunct("(") || p.tok.layoutBefore {
+		return name, 0, nil
+	}
+	if err := p.advance(); err != nil {
+		return nil, 0, err
+	}
+
+	args, err := p.parseArgs(")")
+	if err != nil {
+		return nil, 0, err
+	}
+	return Compound{Functor: name, Args: args}, 0, nil
+}
+
+// parsePrefix parses the term following a prefix operator, or the operator as a plain atom when it is not applied.
+func (p *parser) parsePrefix(tok token, maxPrio int) (Term, int, error) {
+	if tok.text == "-" && !p.tok.layoutBefore {
+		switch p.tok.kind {
+		case tokenInteger, tokenFloat:
+			p.tok.text = "-" + p.tok.text
+			return p.parsePrimary(maxPrio)
+		}
+	}
+
+	op, ok := prefixOperators[tok.text]
+	if !ok || !p.startsTerm() {
+		return Atom(tok.text), 0, nil
+	}
+
+	prio, argMax := op.priority, op.priority-1
+	if op.typ == fy {
+		argMax = op.priority
+	}
+	// An operand of a lower priority, e.g. \+b in X = \+b, is accepted as most Prolog systems do, its argument being
+	// bound to the priority of the operand.
+	if prio > maxPrio {
+		prio, argMax = maxPrio, min(argMax, maxPrio)
+	}
+	arg, _, err := p.parse(argMax)
+	if err != nil {
+		return nil, 0, err
+	}
+	return Compound{Functor: Atom(tok.text), Args: []Term{arg}}, prio, nil
+}
+
+// startsTerm tells if the current token can be the start of an operand.
+func (p *parser) startsTerm() bool {
+	switch p.tok.kind {
+	case tokenEOF, tokenEnd:
+		return false
+	case tokenPunct:
+		return p.tok.text == "(" || p.tok.text == "[" || p.tok.text == "{"
+	case tokenAtom:
+		if _, infix := infixOperators[p.tok.text]; infix {
+			_, prefix := prefixOperators[p.tok.text]
+			return prefix
+		}
+	}
+	return true
+}
+
+func (p *parser) parseArgs(closing string) ([]Term, error) {
+	var args []Term
+	for {
+		arg, err := p.parseArg()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+
+		if !p.isPunct(",") {
+			return args, p.expect(closing)
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *parser) parseList() (Term, int, error) {
+	if err := p.advance(); err != nil {
+		return nil, 0, err
+	}
+	if p.isPunct("]") {
+		return List{}, 0, p.advance()
+	}
+
+	list := List{}
+	for {
+		elem, err := p.parseArg()
+		if err != nil {
+			return nil, 0, err
+		}
+		list.Elements = append(list.Elements, elem)
+
+		switch {
+		case p.isPunct(","):
+			if err := p.advance(); err != nil {
+				return nil, 0, err
+			}
+		case p.isPunct("|"):
+			if err := p.advance(); err != nil {
+				return nil, 0, err
+			}
+			if list.Tail, err = p.parseArg(); err != nil {
+				return nil, 0, err
+			}
+			return list, 0, p.expect("]")
+		default:
+			return list, 0, p.expect("]")
+		}
+	}
+}
+
+func (p *parser) parseCurly() (Term, int, error) {
+	if err := p.advance(); err != nil {
+		return nil, 0, err
+	}
+	if p.isPunct("}") {
+		if err := p.advance(); err != nil {
+			return nil, 0, err
+		}
+		return p.parseAtomOrCompound("{}")
+	}
+
+	term, err := p.parseNested()
+	if err != nil {
+		return nil, 0, err
+	}
+	return Compound{Functor: "{}", Args: []Term{term}}, 0, p.expect("}")
+}
+
+// parseNested parses a term between parentheses or curly brackets, where the comma and bar tokens are operators again.
+func (p *parser) parseNested() (Term, error) {
+	inArg := p.inArg
+	p.inArg = false
+	defer func() { p.inArg = inArg }()
+
+	term, _, err := p.parse(maxPriority)
+	return term, err
+}
+
+// parseArg parses an argument of a compound term or an element of a list.
+func (p *parser) parseArg() (Term, error) {
+	inArg := p.inArg
+	p.inArg = true
+	defer func() { p.inArg = inArg }()
+
+	term, _, err := p.parse(maxPriority)
+	return term, err
+}
@@ -0,0 +1,335 @@
+package prolog_test
+
+import (
+	"testing"
+
+	"github.com/axone-protocol/axone-sdk/prolog"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    prolog.Term
+		wantErr string
+	}{
+		{
+			name: "atom",
+			text: "permitted",
+			want: prolog.Atom("permitted"),
+		},
+		{
+			name: "quoted atom with comma and escaped quote",
+			text: `'read, it''s \'fine\''`,
+			want: prolog.Atom("read, it's 'fine'"),
+		},
+		{
+			name: "symbol atom",
+			text: "=..",
+			want: prolog.Atom("=.."),
+		},
+		{
+			name: "variable",
+			text: "_Result",
+			want: prolog.Variable("_Result"),
+		},
+		{
+			name: "string with escapes",
+			text: `"line\nnext \x41\ \101\"`,
+			want: prolog.String("line\nnext A A"),
+		},
+		{
+			name: "integers",
+			text: "[42,-7,0'a,0xff,0b101]",
+			want: prolog.List{Elements: []prolog.Term{
+				prolog.Integer(42), prolog.Integer(-7), prolog.Integer('a'), prolog.Integer(255), prolog.Integer(5),
+			}},
+		},
+		{
+			name: "big integers",
+			text: "[123456789012345678901234567890,-98765432109876543210,0xffffffffffffffffff]",
+			want: prolog.List{Elements: []prolog.Term{
+				prolog.BigInteger{Value: bigInt("123456789012345678901234567890")},
+				prolog.BigInteger{Value: bigInt("-98765432109876543210")},
+				prolog.BigInteger{Value: bigInt("4722366482869645213695")},
+			}},
+		},
+		{
+			name: "disjunction and if-then-else in arguments",
+			text: "f(a;b,[c->d;e],(g,h))",
+			want: prolog.Compound{Functor: "f", Args: []prolog.Term{
+				prolog.Compound{Functor: ";", Args: []prolog.Term{prolog.Atom("a"), prolog.Atom("b")}},
+				prolog.List{Elements: []prolog.Term{prolog.Compound{Functor: ";", Args: []prolog.Term{
+					prolog.Compound{Functor: "->", Args: []prolog.Term{prolog.Atom("c"), prolog.Atom("d")}},
+					prolog.Atom("e"),
+				}}}},
+				prolog.Compound{Functor: ",", Args: []prolog.Term{prolog.Atom("g"), prolog.Atom("h")}},
+			}},
+		},
+		{
+			name: "negation as operand",
+			text: `X = \+b, f(\+ c)`,
+			want: prolog.Compound{Functor: ",", Args: []prolog.Term{
+				prolog.Compound{Functor: "=", Args: []prolog.Term{
+					prolog.Variable("X"),
+					prolog.Compound{Functor: `\+`, Args: []prolog.Term{prolog.Atom("b")}},
+				}},
+				prolog.Compound{Functor: "f", Args: []prolog.Term{
+					prolog.Compound{Functor: `\+`, Args: []prolog.Term{prolog.Atom("c")}},
+				}},
+			}},
+		},
+		{
+			name: "floats",
+			text: "[1.5,-2.0e3,3.0E-2]",
+			want: prolog.List{Elements: []prolog.Term{prolog.Float(1.5), prolog.Float(-2000), prolog.Float(0.03)}},
+		},
+		{
+			name: "empty list",
+			text: "[]",
+			want: prolog.List{},
+		},
+		{
+			name: "list of quoted and unquoted atoms",
+			text: "['read',store,'a,b']",
+			want: prolog.List{Elements: []prolog.Term{prolog.Atom("read"), prolog.Atom("store"), prolog.Atom("a,b")}},
+		},
+		{
+			name: "nested lists and partial list",
+			text: "[[a,[b]],c|T]",
+			want: prolog.List{
+				Elements: []prolog.Term{
+					prolog.List{Elements: []prolog.Term{
+						prolog.Atom("a"),
+						prolog.List{Elements: []prolog.Term{prolog.Atom("b")}},
+					}},
+					prolog.Atom("c"),
+				},
+				Tail: prolog.Variable("T"),
+			},
+		},
+		{
+			name: "compound term",
+			text: "cause('did:key:abc', [read], evidence(\"ok\", 1))",
+			want: prolog.Compound{Functor: "cause", Args: []prolog.Term{
+				prolog.Atom("did:key:abc"),
+				prolog.List{Elements: []prolog.Term{prolog.Atom("read")}},
+				prolog.Compound{Functor: "evidence", Args: []prolog.Term{prolog.String("ok"), prolog.Integer(1)}},
+			}},
+		},
+		{
+			name: "quoted functor",
+			text: "'Permitted action'(read)",
+			want: prolog.Compound{Functor: "Permitted action", Args: []prolog.Term{prolog.Atom("read")}},
+		},
+		{
+			name: "operators with priorities and associativity",
+			text: "a:-b,c;d->e",
+			want: prolog.Compound{Functor: ":-", Args: []prolog.Term{
+				prolog.Atom("a"),
+				prolog.Compound{Functor: ";", Args: []prolog.Term{
+					prolog.Compound{Functor: ",", Args: []prolog.Term{prolog.Atom("b"), prolog.Atom("c")}},
+					prolog.Compound{Functor: "->", Args: []prolog.Term{prolog.Atom("d"), prolog.Atom("e")}},
+				}},
+			}},
+		},
+		{
+			name: "left associative arithmetic",
+			text: "1-2-3*4",
+			want: prolog.Compound{Functor: "-", Args: []prolog.Term{
+				prolog.Compound{Functor: "-", Args: []prolog.Term{prolog.Integer(1), prolog.Integer(2)}},
+				prolog.Compound{Functor: "*", Args: []prolog.Term{prolog.Integer(3), prolog.Integer(4)}},
+			}},
+		},
+		{
+			name: "pairs in list",
+			text: "[read-permitted,store-prohibited]",
+			want: prolog.List{Elements: []prolog.Term{
+				prolog.Compound{Functor: "-", Args: []prolog.Term{prolog.Atom("read"), prolog.Atom("permitted")}},
+				prolog.Compound{Functor: "-", Args: []prolog.Term{prolog.Atom("store"), prolog.Atom("prohibited")}},
+			}},
+		},
+		{
+			name: "prefix operators",
+			text: `\+ - (a)`,
+			want: prolog.Compound{Functor: `\+`, Args: []prolog.Term{
+				prolog.Compound{Functor: "-", Args: []prolog.Term{prolog.Atom("a")}},
+			}},
+		},
+		{
+			name: "operator as atom",
+			text: "f(-, +)",
+			want: prolog.Compound{Functor: "f", Args: []prolog.Term{prolog.Atom("-"), prolog.Atom("+")}},
+		},
+		{
+			name: "parenthesized comma term as argument",
+			text: "f((a,b))",
+			want: prolog.Compound{Functor: "f", Args: []prolog.Term{
+				prolog.Compound{Functor: ",", Args: []prolog.Term{prolog.Atom("a"), prolog.Atom("b")}},
+			}},
+		},
+		{
+			name: "curly term",
+			text: "{a}",
+			want: prolog.Compound{Functor: "{}", Args: []prolog.Term{prolog.Atom("a")}},
+		},
+		{
+			name: "layout, comments and end token",
+			text: " foo( % comment\n a /* block */ ) .",
+			want: prolog.Compound{Functor: "foo", Args: []prolog.Term{prolog.Atom("a")}},
+		},
+		{
+			name:    "unterminated list",
+			text:    "[a,b",
+			wantErr: "syntax error at offset 4: unexpected end of input",
+		},
+		{
+			name:    "unterminated quoted atom",
+			text:    "'abc",
+			wantErr: "syntax error at offset 0: unterminated quoted",
+		},
+		{
+			name:    "trailing term",
+			text:    "a b",
+			wantErr: `syntax error at offset 2: unexpected token "b"`,
+		},
+		{
+			name:    "empty text",
+			text:    "",
+			wantErr: "syntax error at offset 0: unexpected end of input",
+		},
+		{
+			name:    "invalid escape",
+			text:    `'\q'`,
+			wantErr: `syntax error at offset 1: invalid escape sequence \q`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a Prolog text", t, func() {
+				Convey("When it is parsed", func() {
+					term, err := prolog.Parse(test.text)
+
+					Convey("Then the expected term should be returned", func() {
+						if test.wantErr == "" {
+							So(err, ShouldBeNil)
+							So(term, ShouldResemble, test.want)
+
+							reparsed, err := prolog.Parse(term.String())
+							So(err, ShouldBeNil)
+							So(reparsed, ShouldResemble, test.want)
+						} else {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, test.wantErr)
+							So(term, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestParseProgram(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    []prolog.Term
+		wantErr string
+	}{
+		{
+			name: "empty program",
+			text: "% nothing here\n",
+		},
+		{
+			name: "facts, rules and directives",
+			text: `:- discontiguous(tell/4).
+action(read).
+tell(Who, read, permitted, []) :- member(Who), \+ banned(Who).
+`,
+			want: []prolog.Term{
+				prolog.Compound{Functor: ":-", Args: []prolog.Term{
+					prolog.Compound{Functor: "discontiguous", Args: []prolog.Term{
+						prolog.Compound{Functor: "/", Args: []prolog.Term{prolog.Atom("tell"), prolog.Integer(4)}},
+					}},
+				}},
+				prolog.Compound{Functor: "action", Args: []prolog.Term{prolog.Atom("read")}},
+				prolog.Compound{Functor: ":-", Args: []prolog.Term{
+					prolog.Compound{Functor: "tell", Args: []prolog.Term{
+						prolog.Variable("Who"), prolog.Atom("read"), prolog.Atom("permitted"), prolog.List{},
+					}},
+					prolog.Compound{Functor: ",", Args: []prolog.Term{
+						prolog.Compound{Functor: "member", Args: []prolog.Term{prolog.Variable("Who")}},
+						prolog.Compound{Functor: `\+`, Args: []prolog.Term{
+							prolog.Compound{Functor: "banned", Args: []prolog.Term{prolog.Variable("Who")}},
+						}},
+					}},
+				}},
+			},
+		},
+		{
+			name: "declaration directives",
+			text: ":- dynamic foo/1.\n:- discontiguous tell/4, action/1.\n",
+			want: []prolog.Term{
+				prolog.Compound{Functor: ":-", Args: []prolog.Term{
+					prolog.Compound{Functor: "dynamic", Args: []prolog.Term{
+						prolog.Compound{Functor: "/", Args: []prolog.Term{prolog.Atom("foo"), prolog.Integer(1)}},
+					}},
+				}},
+				prolog.Compound{Functor: ":-", Args: []prolog.Term{
+					prolog.Compound{Functor: "discontiguous", Args: []prolog.Term{
+						prolog.Compound{Functor: ",", Args: []prolog.Term{
+							prolog.Compound{Functor: "/", Args: []prolog.Term{prolog.Atom("tell"), prolog.Integer(4)}},
+							prolog.Compound{Functor: "/", Args: []prolog.Term{prolog.Atom("action"), prolog.Integer(1)}},
+						}},
+					}},
+				}},
+			},
+		},
+		{
+			name: "bar operator",
+			text: "a :- b | c.\na :- b | c ; d.\n",
+			want: []prolog.Term{
+				prolog.Compound{Functor: ":-", Args: []prolog.Term{
+					prolog.Atom("a"),
+					prolog.Compound{Functor: "|", Args: []prolog.Term{prolog.Atom("b"), prolog.Atom("c")}},
+				}},
+				prolog.Compound{Functor: ":-", Args: []prolog.Term{
+					prolog.Atom("a"),
+					prolog.Compound{Functor: "|", Args: []prolog.Term{
+						prolog.Atom("b"),
+						prolog.Compound{Functor: ";", Args: []prolog.Term{prolog.Atom("c"), prolog.Atom("d")}},
+					}},
+				}},
+			},
+		},
+		{
+			name:    "missing end token",
+			text:    "action(read).\naction(write)",
+			wantErr: "syntax error at offset 27: unexpected end of input",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a Prolog program", t, func() {
+				Convey("When it is parsed", func() {
+					clauses, err := prolog.ParseProgram(test.text)
+
+					Convey("Then the expected clauses should be returned", func() {
+						if test.wantErr == "" {
+							So(err, ShouldBeNil)
+							So(clauses, ShouldResemble, test.want)
+						} else {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, test.wantErr)
+							So(clauses, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package prolog
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrEmptyQuery is returned when building a query without any goal.
+var ErrEmptyQuery = errors.New("empty query")
+
+// Query builds a Prolog query made of a conjunction of goals.
+//
+// Every atom and string given as argument is quoted and escaped, so that its text, such as a DID coming from a
+// request, cannot alter the structure of the query.
+type Query struct {
+	goals []Compound
+}
+
+// NewQuery creates an empty query.
+func NewQuery() *Query {
+	return &Query{}
+}
+
+// Goal adds to the query a goal calling the given predicate with the given arguments.
+func (q *Query) Goal(predicate string, args ...Term) *Query {
+	q.goals = append(q.goals, Compound{Functor: Atom(predicate), Args: args})
+	return q
+}
+
+// Build returns the text of the query, terminated by an end token.
+func (q *Query) Build() (string, error) {
+	if len(q.goals) == 0 {
+		return "", ErrEmptyQuery
+	}
+
+	var b strings.Builder
+	for i, goal := range q.goals {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(goal.Functor.String())
+		if len(goal.Args) == 0 {
+			continue
+		}
+		if err := writeArgs(&b, goal.Args); err != nil {
+			return "", err
+		}
+	}
+	// A symbol atom ending the query would be read along with the end token as a single atom.
+	if r, _ := utf8.DecodeLastRuneInString(b.String()); isSymbolChar(r) {
+		b.WriteByte(' ')
+	}
+	b.WriteByte('.')
+
+	return b.String(), nil
+}
+
+func writeArgs(b *strings.Builder, args []Term) error {
+	b.WriteByte('(')
+	for i, arg := range args {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if err := writeQuoted(b, arg); err != nil {
+			return err
+		}
+	}
+	b.WriteByte(')')
+	return nil
+}
+
+// writeQuoted writes the given term with all its atoms quoted.
+func writeQuoted(b *strings.Builder, t Term) error {
+	switch v := t.(type) {
+	case Atom:
+		b.WriteString("'" + escape(string(v), '\'') + "'")
+	case Variable:
+		if !isVariableName(string(v)) {
+			return fmt.Errorf("invalid variable name %q", string(v))
+		}
+		b.WriteString(string(v))
+	case List:
+		b.WriteByte('[')
+		for i, e := range v.Elements {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if err := writeQuoted(b, e); err != nil {
+				return err
+			}
+		}
+		if v.Tail != nil {
+			b.WriteByte('|')
+			if err := writeQuoted(b, v.Tail); err != nil {
+				return err
+			}
+		}
+		b.WriteByte(']')
+	case Compound:
+		if err := writeQuoted(b, v.Functor); err != nil {
+			return err
+		}
+		if len(v.Args) == 0 {
+			return nil
+		}
+		return writeArgs(b, v.Args)
+	case Float:
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return fmt.Errorf("non-finite float %s", v)
+		}
+		b.WriteString(v.String())
+	case nil:
+		return errors.New("nil term")
+	default:
+		b.WriteString(t.String())
+	}
+	return nil
+}
+
+func isVariableName(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	if r != '_' && !unicode.IsUpper(r) {
+		return false
+	}
+	for _, r := range s {
+		if !isAlnum(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,113 @@
+package prolog_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/axone-protocol/axone-sdk/prolog"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestQuery_Build(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   *prolog.Query
+		want    string
+		wantErr string
+	}{
+		{
+			name: "permitted actions query",
+			query: prolog.NewQuery().
+				Goal("tell_permitted_actions", prolog.Atom("did:key:abc"), prolog.Variable("Actions")),
+			want: "tell_permitted_actions('did:key:abc',Actions).",
+		},
+		{
+			name: "tell query",
+			query: prolog.NewQuery().
+				Goal("tell", prolog.Atom("did:key:abc"), prolog.Atom("read"), prolog.Variable("Result"), prolog.Variable("_")),
+			want: "tell('did:key:abc','read',Result,_).",
+		},
+		{
+			name: "atom trying to inject a goal",
+			query: prolog.NewQuery().
+				Goal("tell", prolog.Atom("did:key:abc','read',permitted,_),halt,tell('x"), prolog.Variable("Result")),
+			want: `tell('did:key:abc\',\'read\',permitted,_),halt,tell(\'x',Result).`,
+		},
+		{
+			name: "atom with backslash and newline",
+			query: prolog.NewQuery().
+				Goal("foo", prolog.Atom("a\\'\nb")),
+			want: `foo('a\\\'\nb').`,
+		},
+		{
+			name: "conjunction of goals with structured arguments",
+			query: prolog.NewQuery().
+				Goal("member", prolog.Variable("X"), prolog.List{
+					Elements: []prolog.Term{prolog.Atom("a"), prolog.String(`"b"`), prolog.Integer(1)},
+					Tail:     prolog.Variable("T"),
+				}).
+				Goal("f", prolog.Compound{Functor: "g", Args: []prolog.Term{prolog.Atom("x")}}, prolog.Compound{Functor: "h"}).
+				Goal("true"),
+			want: `member(X,['a',"\"b\"",1|T]),f('g'('x'),'h'),true.`,
+		},
+		{
+			name:  "predicate name needing quotes",
+			query: prolog.NewQuery().Goal("Tell", prolog.Atom("a")),
+			want:  "'Tell'('a').",
+		},
+		{
+			name:  "solo and comment atoms with float",
+			query: prolog.NewQuery().Goal("foo", prolog.Atom("."), prolog.Atom("/*"), prolog.Float(1e10)),
+			want:  "foo('.','/*',1.0e10).",
+		},
+		{
+			name:  "predicate names being solo and symbol atoms",
+			query: prolog.NewQuery().Goal(".", prolog.Atom("a")).Goal("/*").Goal("=.."),
+			want:  "'.'('a'),'/*',=.. .",
+		},
+		{
+			name:    "empty query",
+			query:   prolog.NewQuery(),
+			wantErr: "empty query",
+		},
+		{
+			name:    "invalid variable name",
+			query:   prolog.NewQuery().Goal("foo", prolog.Variable("X),halt,foo(Y")),
+			wantErr: `invalid variable name "X),halt,foo(Y"`,
+		},
+		{
+			name:    "non-finite float",
+			query:   prolog.NewQuery().Goal("foo", prolog.List{Elements: []prolog.Term{prolog.Float(math.Inf(-1))}}),
+			wantErr: "non-finite float -inf",
+		},
+		{
+			name:    "nil argument",
+			query:   prolog.NewQuery().Goal("foo", nil),
+			wantErr: "nil term",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a query", t, func() {
+				Convey("When it is built", func() {
+					text, err := test.query.Build()
+
+					Convey("Then the expected Prolog text should be returned", func() {
+						if test.wantErr == "" {
+							So(err, ShouldBeNil)
+							So(text, ShouldEqual, test.want)
+
+							_, err := prolog.Parse(text)
+							So(err, ShouldBeNil)
+						} else {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, test.wantErr)
+							So(text, ShouldBeEmpty)
+						}
+					})
+				})
+			})
+		})
+	}
+}
@@ -0,0 +1,224 @@
+// Package prolog provides a representation of Prolog terms and a parser for the terms found in the answers of the
+// law-stone smart contract.
+package prolog
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Term is a Prolog term. Its String representation is valid Prolog syntax which can be parsed back to the same term,
+// except for the non-finite floats.
+type Term interface {
+	String() string
+	isTerm()
+}
+
+var (
+	_ Term = Atom("")
+	_ Term = Variable("")
+	_ Term = String("")
+	_ Term = Integer(0)
+	_ Term = BigInteger{}
+	_ Term = Float(0)
+	_ Term = List{}
+	_ Term = Compound{}
+)
+
+// Atom is a Prolog atom, e.g. foo or 'Hello, World!'.
+type Atom string
+
+// Variable is a Prolog variable, e.g. X or _Result.
+type Variable string
+
+// String is a double-quoted Prolog string, e.g. "hello".
+type String string
+
+// Integer is a Prolog integer.
+type Integer int64
+
+// BigInteger is a Prolog integer which does not fit in an Integer.
+type BigInteger struct {
+	Value *big.Int
+}
+
+// Float is a Prolog floating point number. NaN and the infinities having no Prolog syntax, they are written as the
+// nan, inf and -inf evaluable atoms, which are not read back as floats, and are rejected by Query.Build.
+type Float float64
+
+// List is a Prolog list. Tail is nil for proper lists, and holds the remainder of partial lists, e.g. [a,b|T].
+type List struct {
+	Elements []Term
+	Tail     Term
+}
+
+// Compound is a Prolog compound term, e.g. foo(bar, Baz).
+type Compound struct {
+	Functor Atom
+	Args    []Term
+}
+
+func (Atom) isTerm()       {}
+func (Variable) isTerm()   {}
+func (String) isTerm()     {}
+func (Integer) isTerm()    {}
+func (BigInteger) isTerm() {}
+func (Float) isTerm()      {}
+func (List) isTerm()       {}
+func (Compound) isTerm()   {}
+
+func (a Atom) String() string {
+	if isUnquotedAtom(string(a)) {
+		return string(a)
+	}
+	return "'" + escape(string(a), '\'') + "'"
+}
+
+func (v Variable) String() string {
+	return string(v)
+}
+
+func (s String) String() string {
+	return `"` + escape(string(s), '"') + `"`
+}
+
+func (i Integer) String() string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+func (i BigInteger) String() string {
+	return i.Value.String()
+}
+
+func (f Float) String() string {
+	switch {
+	case math.IsNaN(float64(f)):
+		return "nan"
+	case math.IsInf(float64(f), 1):
+		return "inf"
+	case math.IsInf(float64(f), -1):
+		return "-inf"
+	}
+
+	s := strconv.FormatFloat(float64(f), 'g', -1, 64)
+
+	// Prolog requires a fraction in the mantissa of a float, even when written with an exponent.
+	mantissa, exponent, hasExponent := strings.Cut(s, "e")
+	if !strings.Contains(mantissa, ".") {
+		mantissa += ".0"
+	}
+	if !hasExponent {
+		return mantissa
+	}
+	return mantissa + "e" + strings.TrimPrefix(exponent, "+")
+}
+
+func (l List) String() string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, e := range l.Elements {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(e.String())
+	}
+	if l.Tail != nil {
+		b.WriteByte('|')
+		b.WriteString(l.Tail.String())
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+func (c Compound) String() string {
+	// A compound without argument has no standard syntax, it is written as its functor.
+	if len(c.Args) == 0 {
+		return c.Functor.String()
+	}
+
+	var b strings.Builder
+	b.WriteString(c.Functor.String())
+	b.WriteByte('(')
+	for i, arg := range c.Args {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(arg.String())
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// Text returns the textual content of atomic terms: the name of an atom or the content of a string. For any other
+// term, it returns its Prolog representation.
+func Text(t Term) string {
+	switch v := t.(type) {
+	case Atom:
+		return string(v)
+	case String:
+		return string(v)
+	default:
+		return t.String()
+	}
+}
+
+func isUnquotedAtom(s string) bool {
+	switch s {
+	case "", ",", "|", "[]", ".":
+		return false
+	case "{}", "!", ";":
+		return true
+	}
+	if strings.HasPrefix(s, "/*") {
+		return false
+	}
+
+	r, _ := utf8.DecodeRuneInString(s)
+	if unicode.IsLower(r) {
+		for _, r := range s {
+			if !isAlnum(r) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, r := range s {
+		if !isSymbolChar(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func escape(s string, quote rune) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case quote, '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func isAlnum(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isSymbolChar(r rune) bool {
+	return strings.ContainsRune(`+-*/\^<>=~:.?@#&$`, r)
+}
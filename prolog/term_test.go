@@ -0,0 +1,112 @@
+package prolog_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/axone-protocol/axone-sdk/prolog"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTerm_String(t *testing.T) {
+	tests := []struct {
+		name string
+		term prolog.Term
+		want string
+		// parsed is the term parsed back when it differs from the formatted one, the latter having no syntax of its own.
+		parsed prolog.Term
+	}{
+		{name: "plain atom", term: prolog.Atom("read"), want: "read"},
+		{name: "atom needing quotes", term: prolog.Atom("did:key:abc"), want: "'did:key:abc'"},
+		{name: "capitalized atom", term: prolog.Atom("Read"), want: "'Read'"},
+		{name: "atom with quote", term: prolog.Atom(`it's\`), want: `'it\'s\\'`},
+		{name: "empty atom", term: prolog.Atom(""), want: "''"},
+		{name: "symbol atom", term: prolog.Atom(":-"), want: ":-"},
+		{name: "empty list atom", term: prolog.Atom("[]"), want: "'[]'"},
+		{name: "end atom", term: prolog.Atom("."), want: "'.'"},
+		{name: "comment start atom", term: prolog.Atom("/*"), want: "'/*'"},
+		{name: "symbol atom starting as a comment", term: prolog.Atom("/**/"), want: "'/**/'"},
+		{name: "variable", term: prolog.Variable("X"), want: "X"},
+		{name: "string", term: prolog.String("a \"b\"\n"), want: `"a \"b\"\n"`},
+		{name: "integer", term: prolog.Integer(-3), want: "-3"},
+		{name: "float", term: prolog.Float(2), want: "2.0"},
+		{name: "float with exponent", term: prolog.Float(1e10), want: "1.0e10"},
+		{name: "float with fraction and negative exponent", term: prolog.Float(1.5e-7), want: "1.5e-07"},
+		{name: "not a number", term: prolog.Float(math.NaN()), want: "nan", parsed: prolog.Atom("nan")},
+		{
+			name:   "negative infinity",
+			term:   prolog.Float(math.Inf(-1)),
+			want:   "-inf",
+			parsed: prolog.Compound{Functor: "-", Args: []prolog.Term{prolog.Atom("inf")}},
+		},
+		{
+			name: "big integer",
+			term: prolog.BigInteger{Value: bigInt("-123456789012345678901234567890")},
+			want: "-123456789012345678901234567890",
+		},
+		{name: "empty list", term: prolog.List{}, want: "[]"},
+		{
+			name: "partial list",
+			term: prolog.List{Elements: []prolog.Term{prolog.Atom("a"), prolog.Integer(1)}, Tail: prolog.Variable("T")},
+			want: "[a,1|T]",
+		},
+		{
+			name: "compound",
+			term: prolog.Compound{Functor: "tell", Args: []prolog.Term{prolog.Atom("did:key:abc"), prolog.Variable("R")}},
+			want: "tell('did:key:abc',R)",
+		},
+		{
+			name:   "compound without argument",
+			term:   prolog.Compound{Functor: "foo"},
+			want:   "foo",
+			parsed: prolog.Atom("foo"),
+		},
+		{
+			name: "operator compounds",
+			term: prolog.Compound{Functor: ";", Args: []prolog.Term{
+				prolog.Compound{Functor: `\+`, Args: []prolog.Term{prolog.Atom("a")}},
+				prolog.Atom("b"),
+			}},
+			want: `;(\+(a),b)`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a Prolog term", t, func() {
+				Convey("When it is formatted", func() {
+					text := test.term.String()
+
+					Convey("Then it should be valid Prolog text parsing back to the same term", func() {
+						So(text, ShouldEqual, test.want)
+
+						parsed, err := prolog.Parse(text)
+						So(err, ShouldBeNil)
+						if test.parsed != nil {
+							So(parsed, ShouldResemble, test.parsed)
+						} else {
+							So(parsed, ShouldResemble, test.term)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	Convey("Given atomic and compound terms", t, func() {
+		Convey("Then Text should return the textual content of atoms and strings", func() {
+			So(prolog.Text(prolog.Atom("did:key:abc")), ShouldEqual, "did:key:abc")
+			So(prolog.Text(prolog.String("hello")), ShouldEqual, "hello")
+			So(prolog.Text(prolog.Integer(1)), ShouldEqual, "1")
+			So(prolog.Text(prolog.Compound{Functor: "f", Args: []prolog.Term{prolog.Atom("A")}}), ShouldEqual, "f('A')")
+		})
+	})
+}
+
+func bigInt(s string) *big.Int {
+	v, _ := new(big.Int).SetString(s, 10)
+	return v
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/axone-protocol/axone-sdk/credential"
+	"github.com/axone-protocol/axone-sdk/credential/template"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/keys"
+	"github.com/axone-protocol/axone-sdk/tx"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/piprate/json-gold/ld"
+)
+
+// txPollInterval is the interval at which the inclusion of the registration transactions in a block is polled.
+const txPollInterval = time.Second
+
+// Registration holds the credentials submitted to the dataverse to register a digital service along with their
+// transaction responses, once included in a block.
+type Registration struct {
+	// Description is the DigitalServiceDescriptionCredential of the service.
+	Description *verifiable.Credential
+	// DescriptionTx is the response of the description submission transaction.
+	DescriptionTx *types.TxResponse
+	// Governance is the GovernanceTextCredential linking the service to its governance.
+	Governance *verifiable.Credential
+	// GovernanceTx is the response of the governance submission transaction.
+	GovernanceTx *types.TxResponse
+}
+
+// RegisterService registers the digital service identified by the DID of the given key in the dataverse, making it
+// usable by services such as the storage.Proxy.
+//
+// It issues the service description and its governance credential linking it to the provided governance address (i.e.
+// law-stone smart contract address), signs both of them with the service key and submits them to the dataverse.
+// The description being submitted first, if the governance submission fails the service is described but not governed.
+//
+// Each transaction is waited for through the given getter, e.g. the tx client created by tx.NewClient, until included
+// in a block, so that the governance one is signed with the account sequence following the description one. A
+// transaction failing, either when broadcast or once included, results in an ErrTxFailed error.
+func RegisterService(
+	ctx context.Context,
+	dvClient dataverse.TxClient,
+	getter tx.Getter,
+	key keys.Keyring,
+	documentLoader ld.DocumentLoader,
+	govAddr, title string,
+	opts ...template.Option[*template.ServiceDescriptor],
+) (*Registration, error) {
+	parser := credential.NewDefaultParser(documentLoader)
+
+	description, err := credential.New(
+		template.NewService(key.DID(), title, opts...),
+		credential.WithParser(parser),
+		credential.WithSigner(key),
+	).Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue service description: %w", err)
+	}
+
+	governance, err := credential.New(
+		template.NewGovernance(key.DID(), "contract:law-stone:"+govAddr),
+		credential.WithParser(parser),
+		credential.WithSigner(key),
+	).Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue service governance: %w", err)
+	}
+
+	descriptionTx, err := submitIncluded(ctx, dvClient, getter, description)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit service description: %w", err)
+	}
+
+	governanceTx, err := submitIncluded(ctx, dvClient, getter, governance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit service governance: %w", err)
+	}
+
+	return &Registration{
+		Description:   description,
+		DescriptionTx: descriptionTx,
+		Governance:    governance,
+		GovernanceTx:  governanceTx,
+	}, nil
+}
+
+// submitIncluded submits the claims of the given credential and waits for the transaction to be included in a block,
+// an error being returned if it fails.
+func submitIncluded(
+	ctx context.Context,
+	dvClient dataverse.TxClient,
+	getter tx.Getter,
+	vc *verifiable.Credential,
+) (*types.TxResponse, error) {
+	resp, err := dvClient.SubmitClaims(ctx, vc)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, dataverse.NewDVError(dataverse.ErrTxFailed, fmt.Errorf("code %d: %s", resp.Code, resp.RawLog))
+	}
+
+	resp, err = tx.WaitTx(ctx, getter, resp.TxHash, txPollInterval)
+	if err != nil {
+		return nil, dataverse.NewDVError(dataverse.ErrSendTx, err)
+	}
+	if resp.Code != 0 {
+		return nil, dataverse.NewDVError(dataverse.ErrTxFailed, fmt.Errorf("code %d: %s", resp.Code, resp.RawLog))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,169 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/axone-protocol/axone-sdk/credential/template"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/provider"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+const serviceDID = "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5"
+
+// submission is the outcome of the submission of a registration transaction.
+type submission struct {
+	err          error
+	code         uint32
+	includedCode uint32
+}
+
+func TestRegisterService(t *testing.T) {
+	tests := []struct {
+		name        string
+		submissions []submission
+		wantCalls   []string
+		wantErr     error
+	}{
+		{
+			name:        "register service",
+			submissions: []submission{{}, {}},
+			wantCalls:   []string{"submit hash1", "get hash1", "submit hash2", "get hash2"},
+		},
+		{
+			name:        "description submission failure",
+			submissions: []submission{{err: fmt.Errorf("insufficient fees")}},
+			wantCalls:   []string{"submit hash1"},
+			wantErr:     fmt.Errorf("failed to submit service description: insufficient fees"),
+		},
+		{
+			name:        "description transaction rejected",
+			submissions: []submission{{code: 32}},
+			wantCalls:   []string{"submit hash1"},
+			wantErr: fmt.Errorf("failed to submit service description: %w",
+				dataverse.NewDVError(dataverse.ErrTxFailed, fmt.Errorf("code 32: account sequence mismatch"))),
+		},
+		{
+			name:        "description transaction failed once included",
+			submissions: []submission{{includedCode: 5}},
+			wantCalls:   []string{"submit hash1", "get hash1"},
+			wantErr: fmt.Errorf("failed to submit service description: %w",
+				dataverse.NewDVError(dataverse.ErrTxFailed, fmt.Errorf("code 5: out of gas"))),
+		},
+		{
+			name:        "governance submission failure",
+			submissions: []submission{{}, {err: fmt.Errorf("insufficient fees")}},
+			wantCalls:   []string{"submit hash1", "get hash1", "submit hash2"},
+			wantErr:     fmt.Errorf("failed to submit service governance: insufficient fees"),
+		},
+		{
+			name:        "governance transaction rejected",
+			submissions: []submission{{}, {code: 32}},
+			wantCalls:   []string{"submit hash1", "get hash1", "submit hash2"},
+			wantErr: fmt.Errorf("failed to submit service governance: %w",
+				dataverse.NewDVError(dataverse.ErrTxFailed, fmt.Errorf("code 32: account sequence mismatch"))),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a service key and mocked dataverse and tx clients", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				mockKeyring := testutil.NewMockKeyring(controller)
+				mockKeyring.EXPECT().DID().Return(serviceDID).AnyTimes()
+				mockKeyring.EXPECT().DIDKeyID().Return(serviceDID + "#key").AnyTimes()
+				mockKeyring.EXPECT().Alg().Return("secp256k1").AnyTimes()
+				mockKeyring.EXPECT().Sign(gomock.Any()).Return([]byte("signature"), nil).AnyTimes()
+
+				var calls []string
+				var submitted []*verifiable.Credential
+				mockDataverse := testutil.NewMockDataverseTxClient(controller)
+				mockDataverse.EXPECT().
+					SubmitClaims(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						vc *verifiable.Credential,
+						_ ...dataverse.SubmitOption,
+					) (*types.TxResponse, error) {
+						submitted = append(submitted, vc)
+						calls = append(calls, fmt.Sprintf("submit hash%d", len(submitted)))
+						outcome := test.submissions[len(submitted)-1]
+						if outcome.err != nil {
+							return nil, outcome.err
+						}
+						return &types.TxResponse{
+							TxHash: fmt.Sprintf("hash%d", len(submitted)),
+							Code:   outcome.code,
+							RawLog: "account sequence mismatch",
+						}, nil
+					}).
+					AnyTimes()
+
+				mockGetter := testutil.NewMockTxGetter(controller)
+				mockGetter.EXPECT().
+					GetTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, hash string) (*types.TxResponse, error) {
+						calls = append(calls, "get "+hash)
+						return &types.TxResponse{
+							TxHash: hash,
+							Height: 42,
+							Code:   test.submissions[len(submitted)-1].includedCode,
+							RawLog: "out of gas",
+						}, nil
+					}).
+					AnyTimes()
+
+				loader, err := testutil.MockDocumentLoader()
+				So(err, ShouldBeNil)
+
+				Convey("When RegisterService is called", func() {
+					registration, err := provider.RegisterService(
+						context.Background(),
+						mockDataverse,
+						mockGetter,
+						mockKeyring,
+						loader,
+						"axone1govaddr",
+						"title",
+						template.WithDescription[*template.ServiceDescriptor]("description"),
+					)
+
+					Convey("Then the signed credentials should be submitted one transaction after the other", func() {
+						So(calls, ShouldResemble, test.wantCalls)
+						So(submitted[0].Types, ShouldContain, "DigitalServiceDescriptionCredential")
+						So(submitted[0].Issuer.ID, ShouldEqual, serviceDID)
+						So(submitted[0].Proofs, ShouldHaveLength, 1)
+						if len(submitted) > 1 {
+							So(submitted[1].Types, ShouldContain, "GovernanceTextCredential")
+							So(submitted[1].Proofs, ShouldHaveLength, 1)
+							raw, err := submitted[1].MarshalJSON()
+							So(err, ShouldBeNil)
+							So(string(raw), ShouldContainSubstring, `"contract:law-stone:axone1govaddr"`)
+						}
+
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							So(registration.Description, ShouldEqual, submitted[0])
+							So(registration.DescriptionTx.TxHash, ShouldEqual, "hash1")
+							So(registration.DescriptionTx.Height, ShouldEqual, 42)
+							So(registration.Governance, ShouldEqual, submitted[1])
+							So(registration.GovernanceTx.TxHash, ShouldEqual, "hash2")
+							So(registration.GovernanceTx.Height, ShouldEqual, 42)
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(registration, ShouldBeNil)
+						}
+					})
+				})
+			})
+		})
+	}
+}
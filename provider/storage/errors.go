@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/axone-protocol/axone-sdk/dataverse"
+)
+
+// ErrUnauthorized is returned when an identity is not authorized to perform an action.
+var ErrUnauthorized = errors.New("unauthorized")
+
+// UnauthorizedError is returned when the governance of a resource does not permit an identity to perform an action on
+// it. It holds the decision of the governance, giving the reason of the denial. It matches ErrUnauthorized.
+type UnauthorizedError struct {
+	Decision *dataverse.Decision
+}
+
+func (e *UnauthorizedError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrUnauthorized, e.Decision)
+}
+
+func (e *UnauthorizedError) Unwrap() error {
+	return ErrUnauthorized
+}
@@ -3,7 +3,6 @@ package storage
 import (
 	"bytes"
 	"context"
-	"errors"
 	"io"
 
 	"github.com/axone-protocol/axone-sdk/auth"
@@ -75,10 +74,11 @@ func (p *Proxy) Authenticate(ctx context.Context, credential []byte) (*auth.Iden
 //
 // The identity is authorized to read a resource if both the proxied service's governance and the requested resource's
 // governance allows it. To check the proxied service's governance it uses the set of resolved permissions at authentication.
-// To check the requested resource's governance it retrieves it from the dataverse before querying it.
+// To check the requested resource's governance it retrieves it from the dataverse before querying it, a denial from it
+// being returned as an UnauthorizedError holding the governance decision.
 func (p *Proxy) Read(ctx context.Context, id *auth.Identity, resourceID string) (io.Reader, error) {
 	if !id.Can(readAction) {
-		return nil, errors.New("unauthorized")
+		return nil, ErrUnauthorized
 	}
 
 	govAddr, err := p.dvClient.GetResourceGovAddr(ctx, resourceID)
@@ -86,13 +86,13 @@ func (p *Proxy) Read(ctx context.Context, id *auth.Identity, resourceID string)
 		return nil, err
 	}
 
-	ok, err := p.dvClient.AskGovTellAction(ctx, govAddr, id.DID, readAction)
+	decision, err := p.dvClient.AskGovDecision(ctx, govAddr, id.DID, readAction)
 	if err != nil {
 		return nil, err
 	}
 
-	if !ok {
-		return nil, errors.New("unauthorized")
+	if !decision.Permitted() {
+		return nil, &UnauthorizedError{Decision: decision}
 	}
 
 	return p.readFn(ctx, resourceID)
@@ -106,7 +106,7 @@ func (p *Proxy) Read(ctx context.Context, id *auth.Identity, resourceID string)
 // permissions at authentication to do so.
 func (p *Proxy) Store(ctx context.Context, id *auth.Identity, resourceID string, src io.Reader) (io.Reader, error) {
 	if !id.Can(storeAction) {
-		return nil, errors.New("unauthorized")
+		return nil, ErrUnauthorized
 	}
 
 	if err := p.storeFn(ctx, resourceID, src); err != nil {
@@ -0,0 +1,256 @@
+package storage_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	dvschema "github.com/axone-protocol/axone-contract-schema/go/dataverse-schema/v6"
+	lsschema "github.com/axone-protocol/axone-contract-schema/go/law-stone-schema/v6"
+	"github.com/axone-protocol/axone-sdk/auth"
+	"github.com/axone-protocol/axone-sdk/dataverse"
+	"github.com/axone-protocol/axone-sdk/prolog"
+	"github.com/axone-protocol/axone-sdk/provider/storage"
+	"github.com/axone-protocol/axone-sdk/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestProxy_Read(t *testing.T) {
+	const (
+		serviceDID  = "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5"
+		identityDID = "did:key:zQ3shpoUHzwcgdt2gxjqHHnJnNkBVd4uX3ZBhmPiM7J93yqCr"
+	)
+
+	prohibited := &dataverse.Decision{
+		GovAddr:  "axone1resourcegov",
+		DID:      identityDID,
+		Action:   "read",
+		Result:   prolog.Atom("prohibited"),
+		Evidence: prolog.Compound{Functor: "cause", Args: []prolog.Term{prolog.Atom("not a member")}},
+	}
+
+	tests := []struct {
+		name          string
+		identity      *auth.Identity
+		decision      *dataverse.Decision
+		decisionError error
+		wantErr       error
+		wantDecision  *dataverse.Decision
+	}{
+		{
+			name:     "read permitted",
+			identity: &auth.Identity{DID: identityDID, AuthorizedActions: []string{"read"}},
+			decision: &dataverse.Decision{
+				GovAddr: "axone1resourcegov",
+				DID:     identityDID,
+				Action:  "read",
+				Result:  prolog.Atom("permitted"),
+			},
+		},
+		{
+			name:     "read not permitted by the service governance",
+			identity: &auth.Identity{DID: identityDID},
+			wantErr:  storage.ErrUnauthorized,
+		},
+		{
+			name:         "read prohibited by the resource governance",
+			identity:     &auth.Identity{DID: identityDID, AuthorizedActions: []string{"read"}},
+			decision:     prohibited,
+			wantErr:      fmt.Errorf("unauthorized: %s", prohibited),
+			wantDecision: prohibited,
+		},
+		{
+			name:          "resource governance error",
+			identity:      &auth.Identity{DID: identityDID, AuthorizedActions: []string{"read"}},
+			decisionError: fmt.Errorf("failed to query law-stone contract: error"),
+			wantErr:       fmt.Errorf("failed to query law-stone contract: error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a storage proxy", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				mockKeyring := testutil.NewMockKeyring(controller)
+				mockKeyring.EXPECT().DID().Return(serviceDID).AnyTimes()
+
+				mockDataverse := testutil.NewMockQueryClient(controller)
+				mockDataverse.EXPECT().GetResourceGovAddr(gomock.Any(), serviceDID).Return("axone1servicegov", nil)
+				if test.identity.Can("read") {
+					mockDataverse.EXPECT().GetResourceGovAddr(gomock.Any(), "resource").Return("axone1resourcegov", nil)
+					mockDataverse.EXPECT().
+						AskGovDecision(gomock.Any(), "axone1resourcegov", identityDID, "read").
+						Return(test.decision, test.decisionError)
+				}
+
+				loader, err := testutil.MockDocumentLoader()
+				So(err, ShouldBeNil)
+
+				proxy, err := storage.NewProxy(
+					context.Background(),
+					mockKeyring,
+					"https://storage.example.org",
+					mockDataverse,
+					loader,
+					func(_ context.Context, id string) (io.Reader, error) {
+						return strings.NewReader("content of " + id), nil
+					},
+					nil,
+				)
+				So(err, ShouldBeNil)
+
+				Convey("When Read is called", func() {
+					reader, err := proxy.Read(context.Background(), test.identity, "resource")
+
+					Convey("Then the resource should be returned if authorized", func() {
+						if test.wantErr == nil {
+							So(err, ShouldBeNil)
+							content, err := io.ReadAll(reader)
+							So(err, ShouldBeNil)
+							So(string(content), ShouldEqual, "content of resource")
+						} else {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(reader, ShouldBeNil)
+						}
+
+						var unauthorized *storage.UnauthorizedError
+						So(errors.As(err, &unauthorized), ShouldEqual, test.wantDecision != nil)
+						if test.wantDecision != nil {
+							So(unauthorized.Decision, ShouldEqual, test.wantDecision)
+							So(errors.Is(err, storage.ErrUnauthorized), ShouldBeTrue)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+// dialPerLawStoneConn stands for the law-stone clients each dialing their own connection: the queries of the
+// dataverse and cognitarium contracts go through the shared connection while each query of a law-stone contract goes
+// through a new one.
+type dialPerLawStoneConn struct {
+	*grpc.ClientConn
+
+	target    string
+	contracts []string
+}
+
+func (c *dialPerLawStoneConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	req, ok := args.(*wasmtypes.QuerySmartContractStateRequest)
+	if !ok || slices.Contains(c.contracts, req.Address) {
+		return c.ClientConn.Invoke(ctx, method, args, reply, opts...)
+	}
+
+	conn, err := grpc.NewClient(c.target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return conn.Invoke(ctx, method, args, reply, opts...)
+}
+
+func BenchmarkProxy_Read(b *testing.B) {
+	const (
+		serviceDID  = "did:key:zQ3shuwMJWYXRi64qiGojsV9bPN6Dtugz5YFM2ESPtkaNxTZ5"
+		identityDID = "did:key:zQ3shpoUHzwcgdt2gxjqHHnJnNkBVd4uX3ZBhmPiM7J93yqCr"
+		dvAddr      = "axone1dataverse"
+		cgAddr      = "axone1cognitarium"
+	)
+
+	server, err := testutil.NewWasmServer(map[string]any{
+		dvAddr + "/dataverse": dvschema.DataverseResponse{Name: "dataverse", TriplestoreAddress: cgAddr},
+		cgAddr + "/select": json.RawMessage(`{
+			"head": {"vars": ["code"]},
+			"results": {"bindings": [{"code": {"type": "uri", "value": {"full": "contract:law-stone:axone1gov"}}}]}
+		}`),
+		"axone1gov/ask": lsschema.AskResponse{Answer: &lsschema.Answer{
+			Variables: []string{"Result", "Evidence"},
+			Results: []lsschema.Result{{Substitutions: []lsschema.Substitution{
+				{Variable: "Result", Expression: "permitted"},
+				{Variable: "Evidence", Expression: "[]"},
+			}}},
+		}},
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer server.Close()
+
+	loader, err := testutil.MockDocumentLoader()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	benchmarks := []struct {
+		name string
+		conn func(*grpc.ClientConn) grpc.ClientConnInterface
+	}{
+		{
+			name: "connection per law-stone client",
+			conn: func(conn *grpc.ClientConn) grpc.ClientConnInterface {
+				return &dialPerLawStoneConn{ClientConn: conn, target: server.Addr, contracts: []string{dvAddr, cgAddr}}
+			},
+		},
+		{
+			name: "shared connection",
+			conn: func(conn *grpc.ClientConn) grpc.ClientConnInterface {
+				return conn
+			},
+		},
+	}
+
+	for _, bench := range benchmarks {
+		b.Run(bench.name, func(b *testing.B) {
+			controller := gomock.NewController(b)
+			mockKeyring := testutil.NewMockKeyring(controller)
+			mockKeyring.EXPECT().DID().Return(serviceDID).AnyTimes()
+
+			conn, err := grpc.NewClient(server.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				b.Fatal(err)
+			}
+			defer conn.Close()
+
+			dvClient, err := dataverse.NewQueryClientFromConn(context.Background(), bench.conn(conn), dvAddr)
+			if err != nil {
+				b.Fatal(err)
+			}
+			proxy, err := storage.NewProxy(
+				context.Background(),
+				mockKeyring,
+				"https://storage.example.org",
+				dvClient,
+				loader,
+				func(_ context.Context, id string) (io.Reader, error) {
+					return strings.NewReader("content of " + id), nil
+				},
+				nil,
+			)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			identity := &auth.Identity{DID: identityDID, AuthorizedActions: []string{"read"}}
+			conns := server.Conns()
+			b.ResetTimer()
+			for range b.N {
+				if _, err := proxy.Read(context.Background(), identity, "resource"); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(server.Conns()-conns)/float64(b.N), "conns/op")
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Package shacl validates RDF graphs against shapes of the [SHACL](https://www.w3.org/TR/shacl/) core language, such
+// as the claims of a verifiable credential before their submission to the dataverse.
+//
+// Only a subset of SHACL core is supported: node shapes targeting a class, whose property shapes have a predicate
+// path and constrain the cardinality, datatype, node kind, class, pattern or shape of their values.
+package shacl
+
+import (
+	"github.com/piprate/json-gold/ld"
+)
+
+const (
+	RDFType    = "http://www.w3.org/1999/02/22-rdf-syntax-ns#type"
+	XSDString  = "http://www.w3.org/2001/XMLSchema#string"
+	LangString = "http://www.w3.org/1999/02/22-rdf-syntax-ns#langString"
+)
+
+// Graph is an RDF graph indexed by subject and predicate.
+type Graph struct {
+	subjects map[string]map[string][]ld.Node
+	order    []ld.Node
+}
+
+// NewGraph creates a Graph from the triples of the default graph of the given dataset, named graphs being ignored.
+func NewGraph(dataset *ld.RDFDataset) *Graph {
+	g := &Graph{subjects: make(map[string]map[string][]ld.Node)}
+	for _, quad := range dataset.GetQuads("@default") {
+		g.add(quad.Subject, quad.Predicate.GetValue(), quad.Object)
+	}
+	return g
+}
+
+// ParseNQuads creates a Graph from the default graph of the given dataset serialized in
+// [N-Quads](https://www.w3.org/TR/n-quads/).
+func ParseNQuads(nquads string) (*Graph, error) {
+	dataset, err := ld.ParseNQuads(nquads)
+	if err != nil {
+		return nil, err
+	}
+	return NewGraph(dataset), nil
+}
+
+func (g *Graph) add(subject ld.Node, predicate string, object ld.Node) {
+	properties, ok := g.subjects[subject.GetValue()]
+	if !ok {
+		properties = make(map[string][]ld.Node)
+		g.subjects[subject.GetValue()] = properties
+		g.order = append(g.order, subject)
+	}
+	properties[predicate] = append(properties[predicate], object)
+}
+
+// Objects returns the objects of the triples having the given subject and predicate.
+func (g *Graph) Objects(subject ld.Node, predicate string) []ld.Node {
+	return g.subjects[subject.GetValue()][predicate]
+}
+
+// Instances returns the subjects having the given class as rdf:type, in their order of appearance.
+func (g *Graph) Instances(class string) []ld.Node {
+	var instances []ld.Node
+	for _, subject := range g.order {
+		if g.HasType(subject, class) {
+			instances = append(instances, subject)
+		}
+	}
+	return instances
+}
+
+// HasType tells if the given node has the given class as rdf:type.
+func (g *Graph) HasType(node ld.Node, class string) bool {
+	for _, t := range g.Objects(node, RDFType) {
+		if ld.IsIRI(t) && t.GetValue() == class {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package shacl
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/piprate/json-gold/ld"
+)
+
+const shaclNamespace = "http://www.w3.org/ns/shacl#"
+
+// ParseShapes returns the node shapes of a SHACL shapes graph, i.e. the subjects typed as sh:NodeShape along with the
+// node shapes their property shapes refer to through sh:node. A node shape targeting several classes is returned once
+// per class. Constraints out of the subset supported by this package are ignored.
+func ParseShapes(g *Graph) ([]NodeShape, error) {
+	var shapes []NodeShape
+	for _, node := range g.Instances(shaclNamespace + "NodeShape") {
+		shape, err := parseNodeShape(g, node, map[string]bool{})
+		if err != nil {
+			return nil, err
+		}
+
+		targets := g.Objects(node, shaclNamespace+"targetClass")
+		if len(targets) == 0 {
+			shapes = append(shapes, *shape)
+		}
+		for _, target := range targets {
+			targeted := *shape
+			targeted.TargetClass = target.GetValue()
+			shapes = append(shapes, targeted)
+		}
+	}
+	return shapes, nil
+}
+
+func parseNodeShape(g *Graph, node ld.Node, parsing map[string]bool) (*NodeShape, error) {
+	if parsing[node.GetValue()] {
+		return nil, fmt.Errorf("recursive shape %s", node.GetValue())
+	}
+	parsing[node.GetValue()] = true
+	defer delete(parsing, node.GetValue())
+
+	shape := &NodeShape{ID: node.GetValue(), Properties: []PropertyShape{}}
+	for _, p := range g.Objects(node, shaclNamespace+"property") {
+		property, err := parsePropertyShape(g, p, parsing)
+		if err != nil {
+			return nil, fmt.Errorf("invalid property shape of %s: %w", shape.ID, err)
+		}
+		shape.Properties = append(shape.Properties, *property)
+	}
+	return shape, nil
+}
+
+func parsePropertyShape(g *Graph, node ld.Node, parsing map[string]bool) (*PropertyShape, error) {
+	property := &PropertyShape{}
+
+	path, err := single(g, node, "path")
+	if err != nil {
+		return nil, err
+	}
+	if path == nil || !ld.IsIRI(path) {
+		return nil, fmt.Errorf("expected a predicate path")
+	}
+	property.Path = path.GetValue()
+
+	counts := []struct {
+		name  string
+		count *int
+	}{{"minCount", &property.MinCount}, {"maxCount", &property.MaxCount}}
+	for _, c := range counts {
+		value, err := single(g, node, c.name)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			continue
+		}
+		if *c.count, err = strconv.Atoi(value.GetValue()); err != nil {
+			return nil, fmt.Errorf("invalid sh:%s %s", c.name, value.GetValue())
+		}
+	}
+
+	constraints := []struct {
+		name  string
+		value *string
+	}{{"datatype", &property.Datatype}, {"class", &property.Class}, {"pattern", &property.Pattern}}
+	for _, c := range constraints {
+		value, err := single(g, node, c.name)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			*c.value = value.GetValue()
+		}
+	}
+
+	kind, err := single(g, node, "nodeKind")
+	if err != nil {
+		return nil, err
+	}
+	if kind != nil {
+		property.NodeKind = NodeKind(kind.GetValue())
+	}
+
+	shape, err := single(g, node, "node")
+	if err != nil {
+		return nil, err
+	}
+	if shape != nil {
+		if property.Node, err = parseNodeShape(g, shape, parsing); err != nil {
+			return nil, err
+		}
+	}
+
+	return property, nil
+}
+
+// single returns the only value of the given SHACL property of a node, nil if it has none.
+func single(g *Graph, node ld.Node, name string) (ld.Node, error) {
+	values := g.Objects(node, shaclNamespace+name)
+	switch len(values) {
+	case 0:
+		return nil, nil //nolint:nilnil
+	case 1:
+		return values[0], nil
+	default:
+		return nil, fmt.Errorf("expected a single sh:%s, got %d", name, len(values))
+	}
+}
@@ -0,0 +1,115 @@
+package shacl_test
+
+import (
+	"testing"
+
+	"github.com/axone-protocol/axone-sdk/shacl"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+//nolint:lll // N-Quads statements cannot be wrapped.
+const shapesGraph = `<https://example.org/PersonShape> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://www.w3.org/ns/shacl#NodeShape> .
+<https://example.org/PersonShape> <http://www.w3.org/ns/shacl#targetClass> <https://example.org/Person> .
+<https://example.org/PersonShape> <http://www.w3.org/ns/shacl#property> _:name .
+_:name <http://www.w3.org/ns/shacl#path> <https://example.org/name> .
+_:name <http://www.w3.org/ns/shacl#minCount> "1"^^<http://www.w3.org/2001/XMLSchema#integer> .
+_:name <http://www.w3.org/ns/shacl#maxCount> "1"^^<http://www.w3.org/2001/XMLSchema#integer> .
+_:name <http://www.w3.org/ns/shacl#datatype> <http://www.w3.org/2001/XMLSchema#string> .
+<https://example.org/PersonShape> <http://www.w3.org/ns/shacl#property> _:knows .
+_:knows <http://www.w3.org/ns/shacl#path> <https://example.org/knows> .
+_:knows <http://www.w3.org/ns/shacl#nodeKind> <http://www.w3.org/ns/shacl#BlankNodeOrIRI> .
+_:knows <http://www.w3.org/ns/shacl#class> <https://example.org/Person> .
+_:knows <http://www.w3.org/ns/shacl#node> <https://example.org/FriendShape> .
+<https://example.org/FriendShape> <http://www.w3.org/ns/shacl#property> _:email .
+_:email <http://www.w3.org/ns/shacl#path> <https://example.org/email> .
+_:email <http://www.w3.org/ns/shacl#pattern> "@" .
+`
+
+func TestParseShapes(t *testing.T) {
+	Convey("Given a SHACL shapes graph", t, func() {
+		g, err := shacl.ParseNQuads(shapesGraph)
+		So(err, ShouldBeNil)
+
+		Convey("When its shapes are parsed", func() {
+			shapes, err := shacl.ParseShapes(g)
+
+			Convey("Then the node shapes should be returned with their property shapes", func() {
+				So(err, ShouldBeNil)
+				So(shapes, ShouldResemble, []shacl.NodeShape{
+					{
+						ID:          ex + "PersonShape",
+						TargetClass: ex + "Person",
+						Properties: []shacl.PropertyShape{
+							{
+								Path:     ex + "name",
+								MinCount: 1,
+								MaxCount: 1,
+								Datatype: shacl.XSDString,
+							},
+							{
+								Path:     ex + "knows",
+								NodeKind: shacl.NodeKindBlankNodeOrIRI,
+								Class:    ex + "Person",
+								Node: &shacl.NodeShape{
+									ID:         ex + "FriendShape",
+									Properties: []shacl.PropertyShape{{Path: ex + "email", Pattern: "@"}},
+								},
+							},
+						},
+					},
+				})
+			})
+		})
+	})
+
+	tests := []struct {
+		name    string
+		shapes  string
+		wantErr string
+	}{
+		{
+			name: "missing path",
+			shapes: `<https://example.org/S> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://www.w3.org/ns/shacl#NodeShape> .
+<https://example.org/S> <http://www.w3.org/ns/shacl#property> _:p .
+_:p <http://www.w3.org/ns/shacl#minCount> "1" .
+`,
+			wantErr: "invalid property shape of https://example.org/S: expected a predicate path",
+		},
+		{
+			name: "invalid count",
+			shapes: `<https://example.org/S> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://www.w3.org/ns/shacl#NodeShape> .
+<https://example.org/S> <http://www.w3.org/ns/shacl#property> _:p .
+_:p <http://www.w3.org/ns/shacl#path> <https://example.org/name> .
+_:p <http://www.w3.org/ns/shacl#maxCount> "many" .
+`,
+			wantErr: "invalid property shape of https://example.org/S: invalid sh:maxCount many",
+		},
+		{
+			name: "recursive shape",
+			shapes: `<https://example.org/S> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://www.w3.org/ns/shacl#NodeShape> .
+<https://example.org/S> <http://www.w3.org/ns/shacl#property> _:p .
+_:p <http://www.w3.org/ns/shacl#path> <https://example.org/knows> .
+_:p <http://www.w3.org/ns/shacl#node> <https://example.org/S> .
+`,
+			wantErr: "invalid property shape of https://example.org/S: recursive shape https://example.org/S",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given an invalid SHACL shapes graph", t, func() {
+				g, err := shacl.ParseNQuads(test.shapes)
+				So(err, ShouldBeNil)
+
+				Convey("When its shapes are parsed", func() {
+					shapes, err := shacl.ParseShapes(g)
+
+					Convey("Then an error should be returned", func() {
+						So(err.Error(), ShouldEqual, test.wantErr)
+						So(shapes, ShouldBeNil)
+					})
+				})
+			})
+		})
+	}
+}
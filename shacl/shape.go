@@ -0,0 +1,45 @@
+package shacl
+
+// NodeKind is the kind of RDF term a value must be, as constrained by sh:nodeKind.
+type NodeKind string
+
+const (
+	NodeKindIRI                NodeKind = "http://www.w3.org/ns/shacl#IRI"
+	NodeKindBlankNode          NodeKind = "http://www.w3.org/ns/shacl#BlankNode"
+	NodeKindLiteral            NodeKind = "http://www.w3.org/ns/shacl#Literal"
+	NodeKindBlankNodeOrIRI     NodeKind = "http://www.w3.org/ns/shacl#BlankNodeOrIRI"
+	NodeKindBlankNodeOrLiteral NodeKind = "http://www.w3.org/ns/shacl#BlankNodeOrLiteral"
+	NodeKindIRIOrLiteral       NodeKind = "http://www.w3.org/ns/shacl#IRIOrLiteral"
+)
+
+// NodeShape is a SHACL node shape, i.e. the constraints the nodes it targets must satisfy.
+type NodeShape struct {
+	// ID is the IRI identifying the shape, reported as source shape of the validation results.
+	ID string
+	// TargetClass is the class whose instances are validated against the shape (sh:targetClass). It is ignored when
+	// the shape is the one the values of a property must conform to.
+	TargetClass string
+	// Properties are the property shapes of the node shape (sh:property).
+	Properties []PropertyShape
+}
+
+// PropertyShape is a SHACL property shape constraining the values of a property of a node. Empty constraints are
+// ignored.
+type PropertyShape struct {
+	// Path is the IRI of the predicate whose values are constrained (sh:path).
+	Path string
+	// MinCount is the minimum number of values (sh:minCount).
+	MinCount int
+	// MaxCount is the maximum number of values (sh:maxCount), 0 meaning no maximum.
+	MaxCount int
+	// Datatype is the datatype IRI each value must be a literal of (sh:datatype).
+	Datatype string
+	// NodeKind is the kind of RDF term each value must be (sh:nodeKind).
+	NodeKind NodeKind
+	// Class is the class each value must be an instance of (sh:class).
+	Class string
+	// Pattern is the regular expression the lexical form of each value must match (sh:pattern).
+	Pattern string
+	// Node is the node shape each value must conform to (sh:node).
+	Node *NodeShape
+}
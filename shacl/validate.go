@@ -0,0 +1,237 @@
+package shacl
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/piprate/json-gold/ld"
+)
+
+// Component is the SHACL constraint component a validation result is about.
+type Component string
+
+const (
+	MinCountComponent Component = "http://www.w3.org/ns/shacl#MinCountConstraintComponent"
+	MaxCountComponent Component = "http://www.w3.org/ns/shacl#MaxCountConstraintComponent"
+	DatatypeComponent Component = "http://www.w3.org/ns/shacl#DatatypeConstraintComponent"
+	NodeKindComponent Component = "http://www.w3.org/ns/shacl#NodeKindConstraintComponent"
+	ClassComponent    Component = "http://www.w3.org/ns/shacl#ClassConstraintComponent"
+	PatternComponent  Component = "http://www.w3.org/ns/shacl#PatternConstraintComponent"
+	NodeComponent     Component = "http://www.w3.org/ns/shacl#NodeConstraintComponent"
+)
+
+// Report is the outcome of the validation of a graph against shapes, i.e. a sh:ValidationReport.
+type Report struct {
+	// Conforms tells if the graph conforms to the shapes, i.e. if there is no result.
+	Conforms bool
+	// Results are the constraints violated by the graph.
+	Results []Result
+}
+
+// Result is a constraint violated by a node of the graph, i.e. a sh:ValidationResult.
+type Result struct {
+	// FocusNode is the node validated against the shape.
+	FocusNode string
+	// Path is the IRI of the predicate of the property shape, if any.
+	Path string
+	// Value is the value violating the constraint, if any.
+	Value string
+	// SourceShape is the ID of the node shape.
+	SourceShape string
+	// Component is the constraint component violated.
+	Component Component
+	// Message describes the violation.
+	Message string
+	// Details are the results of the validation of the value against the node shape it must conform to, for a
+	// NodeComponent violation.
+	Details []Result
+}
+
+func (r Result) String() string {
+	var b strings.Builder
+	b.WriteString(r.FocusNode)
+	if r.Path != "" {
+		fmt.Fprintf(&b, " <%s>", r.Path)
+	}
+	fmt.Fprintf(&b, ": %s", r.Message)
+	for _, detail := range r.Details {
+		fmt.Fprintf(&b, " (%s)", detail)
+	}
+	return b.String()
+}
+
+// Err returns a ValidationError carrying the report if the graph does not conform, nil otherwise.
+func (r *Report) Err() error {
+	if r.Conforms {
+		return nil
+	}
+	return &ValidationError{Report: r}
+}
+
+// ValidationError is returned when a graph does not conform to the shapes it is validated against.
+type ValidationError struct {
+	Report *Report
+}
+
+func (e *ValidationError) Error() string {
+	results := make([]string, 0, len(e.Report.Results))
+	for _, r := range e.Report.Results {
+		results = append(results, r.String())
+	}
+	return strings.Join(results, "; ")
+}
+
+// Validate validates the given graph against the given shapes, each node shape being applied to the instances of its
+// target class. An error is returned if a shape is invalid, e.g. if its pattern is not a valid regular expression.
+func Validate(g *Graph, shapes ...NodeShape) (*Report, error) {
+	v := &validator{graph: g, patterns: make(map[string]*regexp.Regexp)}
+
+	report := &Report{Results: []Result{}}
+	for i := range shapes {
+		if shapes[i].TargetClass == "" {
+			continue
+		}
+		for _, focus := range g.Instances(shapes[i].TargetClass) {
+			results, err := v.validateNode(focus, &shapes[i])
+			if err != nil {
+				return nil, err
+			}
+			report.Results = append(report.Results, results...)
+		}
+	}
+	report.Conforms = len(report.Results) == 0
+
+	return report, nil
+}
+
+type validator struct {
+	graph    *Graph
+	patterns map[string]*regexp.Regexp
+}
+
+func (v *validator) validateNode(focus ld.Node, shape *NodeShape) ([]Result, error) {
+	var results []Result
+	for _, property := range shape.Properties {
+		values := v.graph.Objects(focus, property.Path)
+		newResult := func(value ld.Node, component Component, format string, args ...any) Result {
+			result := Result{
+				FocusNode:   focus.GetValue(),
+				Path:        property.Path,
+				SourceShape: shape.ID,
+				Component:   component,
+				Message:     fmt.Sprintf(format, args...),
+			}
+			if value != nil {
+				result.Value = value.GetValue()
+			}
+			return result
+		}
+
+		if len(values) < property.MinCount {
+			results = append(results,
+				newResult(nil, MinCountComponent, "expected at least %d value(s), got %d", property.MinCount, len(values)))
+		}
+		if property.MaxCount > 0 && len(values) > property.MaxCount {
+			results = append(results,
+				newResult(nil, MaxCountComponent, "expected at most %d value(s), got %d", property.MaxCount, len(values)))
+		}
+
+		for _, value := range values {
+			component, message, err := v.checkValue(value, &property)
+			if err != nil {
+				return nil, err
+			}
+			if component != "" {
+				results = append(results, newResult(value, component, "%s", message))
+				continue
+			}
+
+			if property.Node == nil {
+				continue
+			}
+			details, err := v.validateNode(value, property.Node)
+			if err != nil {
+				return nil, err
+			}
+			if len(details) > 0 {
+				result := newResult(value, NodeComponent, "value %s does not conform to shape %s", value.GetValue(), property.Node.ID)
+				result.Details = details
+				results = append(results, result)
+			}
+		}
+	}
+	return results, nil
+}
+
+// checkValue checks the given value against the value type and string based constraints of the property shape,
+// returning the first violated component along with a message.
+func (v *validator) checkValue(value ld.Node, property *PropertyShape) (Component, string, error) {
+	if property.Datatype != "" && datatype(value) != property.Datatype {
+		return DatatypeComponent, fmt.Sprintf("value %s is not a literal of datatype %s", value.GetValue(), property.Datatype), nil
+	}
+	if property.NodeKind != "" && !hasKind(value, property.NodeKind) {
+		return NodeKindComponent, fmt.Sprintf("value %s is not of node kind %s", value.GetValue(), property.NodeKind), nil
+	}
+	if property.Class != "" && !v.graph.HasType(value, property.Class) {
+		return ClassComponent, fmt.Sprintf("value %s is not an instance of %s", value.GetValue(), property.Class), nil
+	}
+	if property.Pattern != "" {
+		pattern, err := v.pattern(property.Pattern)
+		if err != nil {
+			return "", "", err
+		}
+		if ld.IsBlankNode(value) || !pattern.MatchString(value.GetValue()) {
+			return PatternComponent, fmt.Sprintf("value %s does not match pattern %s", value.GetValue(), property.Pattern), nil
+		}
+	}
+	return "", "", nil
+}
+
+func (v *validator) pattern(expr string) (*regexp.Regexp, error) {
+	if pattern, ok := v.patterns[expr]; ok {
+		return pattern, nil
+	}
+	pattern, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %s: %w", expr, err)
+	}
+	v.patterns[expr] = pattern
+	return pattern, nil
+}
+
+// datatype returns the datatype IRI of a literal, empty for other nodes.
+func datatype(node ld.Node) string {
+	literal, ok := node.(*ld.Literal)
+	if !ok {
+		return ""
+	}
+	switch {
+	case literal.Language != "":
+		return LangString
+	case literal.Datatype == "":
+		return XSDString
+	default:
+		return literal.Datatype
+	}
+}
+
+func hasKind(node ld.Node, kind NodeKind) bool {
+	iri, blank, literal := ld.IsIRI(node), ld.IsBlankNode(node), ld.IsLiteral(node)
+	switch kind {
+	case NodeKindIRI:
+		return iri
+	case NodeKindBlankNode:
+		return blank
+	case NodeKindLiteral:
+		return literal
+	case NodeKindBlankNodeOrIRI:
+		return blank || iri
+	case NodeKindBlankNodeOrLiteral:
+		return blank || literal
+	case NodeKindIRIOrLiteral:
+		return iri || literal
+	default:
+		return false
+	}
+}
@@ -0,0 +1,239 @@
+package shacl_test
+
+import (
+	"testing"
+
+	"github.com/axone-protocol/axone-sdk/shacl"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const (
+	ex = "https://example.org/"
+
+	dataGraph = `<https://example.org/alice> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <https://example.org/Person> .
+<https://example.org/alice> <https://example.org/name> "Alice" .
+<https://example.org/alice> <https://example.org/age> "42"^^<http://www.w3.org/2001/XMLSchema#integer> .
+<https://example.org/alice> <https://example.org/knows> _:b0 .
+_:b0 <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <https://example.org/Person> .
+_:b0 <https://example.org/name> "Bob"@en .
+<https://example.org/carol> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <https://example.org/Person> .
+<https://example.org/carol> <https://example.org/name> "Carol" .
+<https://example.org/carol> <https://example.org/name> "Caroline" .
+<https://example.org/carol> <https://example.org/knows> <https://example.org/dave> .
+<https://example.org/carol> <https://example.org/email> "carol-at-example.org" .
+<https://example.org/ignored> <https://example.org/name> "Ignored" .
+`
+)
+
+func TestValidate(t *testing.T) {
+	friendShape := &shacl.NodeShape{
+		ID: ex + "FriendShape",
+		Properties: []shacl.PropertyShape{
+			{Path: ex + "name", MinCount: 1, Datatype: shacl.XSDString},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		shape       shacl.NodeShape
+		wantResults []shacl.Result
+	}{
+		{
+			name: "conforming graph",
+			shape: shacl.NodeShape{
+				ID:          ex + "PersonShape",
+				TargetClass: ex + "Person",
+				Properties: []shacl.PropertyShape{
+					{Path: ex + "name", MinCount: 1, NodeKind: shacl.NodeKindLiteral},
+					{Path: ex + "knows", NodeKind: shacl.NodeKindBlankNodeOrIRI},
+				},
+			},
+			wantResults: []shacl.Result{},
+		},
+		{
+			name: "cardinality violated",
+			shape: shacl.NodeShape{
+				ID:          ex + "PersonShape",
+				TargetClass: ex + "Person",
+				Properties: []shacl.PropertyShape{
+					{Path: ex + "name", MaxCount: 1},
+					{Path: ex + "age", MinCount: 1},
+				},
+			},
+			wantResults: []shacl.Result{
+				{
+					FocusNode:   "_:b0",
+					Path:        ex + "age",
+					SourceShape: ex + "PersonShape",
+					Component:   shacl.MinCountComponent,
+					Message:     "expected at least 1 value(s), got 0",
+				},
+				{
+					FocusNode:   ex + "carol",
+					Path:        ex + "name",
+					SourceShape: ex + "PersonShape",
+					Component:   shacl.MaxCountComponent,
+					Message:     "expected at most 1 value(s), got 2",
+				},
+				{
+					FocusNode:   ex + "carol",
+					Path:        ex + "age",
+					SourceShape: ex + "PersonShape",
+					Component:   shacl.MinCountComponent,
+					Message:     "expected at least 1 value(s), got 0",
+				},
+			},
+		},
+		{
+			name: "values violated",
+			shape: shacl.NodeShape{
+				ID:          ex + "PersonShape",
+				TargetClass: ex + "Person",
+				Properties: []shacl.PropertyShape{
+					{Path: ex + "age", Datatype: "http://www.w3.org/2001/XMLSchema#string"},
+					{Path: ex + "knows", Class: ex + "Person"},
+					{Path: ex + "email", Pattern: "^[^@]+@[^@]+$"},
+				},
+			},
+			wantResults: []shacl.Result{
+				{
+					FocusNode:   ex + "alice",
+					Path:        ex + "age",
+					Value:       "42",
+					SourceShape: ex + "PersonShape",
+					Component:   shacl.DatatypeComponent,
+					Message:     "value 42 is not a literal of datatype http://www.w3.org/2001/XMLSchema#string",
+				},
+				{
+					FocusNode:   ex + "carol",
+					Path:        ex + "knows",
+					Value:       ex + "dave",
+					SourceShape: ex + "PersonShape",
+					Component:   shacl.ClassComponent,
+					Message:     "value https://example.org/dave is not an instance of https://example.org/Person",
+				},
+				{
+					FocusNode:   ex + "carol",
+					Path:        ex + "email",
+					Value:       "carol-at-example.org",
+					SourceShape: ex + "PersonShape",
+					Component:   shacl.PatternComponent,
+					Message:     "value carol-at-example.org does not match pattern ^[^@]+@[^@]+$",
+				},
+			},
+		},
+		{
+			name: "nested shape violated",
+			shape: shacl.NodeShape{
+				ID:          ex + "PersonShape",
+				TargetClass: ex + "Person",
+				Properties: []shacl.PropertyShape{
+					{Path: ex + "knows", NodeKind: shacl.NodeKindBlankNode, Node: friendShape},
+				},
+			},
+			wantResults: []shacl.Result{
+				{
+					FocusNode:   ex + "alice",
+					Path:        ex + "knows",
+					Value:       "_:b0",
+					SourceShape: ex + "PersonShape",
+					Component:   shacl.NodeComponent,
+					Message:     "value _:b0 does not conform to shape https://example.org/FriendShape",
+					Details: []shacl.Result{
+						{
+							FocusNode:   "_:b0",
+							Path:        ex + "name",
+							Value:       "Bob",
+							SourceShape: ex + "FriendShape",
+							Component:   shacl.DatatypeComponent,
+							Message:     "value Bob is not a literal of datatype http://www.w3.org/2001/XMLSchema#string",
+						},
+					},
+				},
+				{
+					FocusNode:   ex + "carol",
+					Path:        ex + "knows",
+					Value:       ex + "dave",
+					SourceShape: ex + "PersonShape",
+					Component:   shacl.NodeKindComponent,
+					Message:     "value https://example.org/dave is not of node kind http://www.w3.org/ns/shacl#BlankNode",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a data graph", t, func() {
+				g, err := shacl.ParseNQuads(dataGraph)
+				So(err, ShouldBeNil)
+
+				Convey("When it is validated against a shape", func() {
+					report, err := shacl.Validate(g, test.shape)
+
+					Convey("Then the expected results should be reported", func() {
+						So(err, ShouldBeNil)
+						So(report.Results, ShouldResemble, test.wantResults)
+						So(report.Conforms, ShouldEqual, len(test.wantResults) == 0)
+						if report.Conforms {
+							So(report.Err(), ShouldBeNil)
+						} else {
+							So(report.Err(), ShouldHaveSameTypeAs, &shacl.ValidationError{})
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	Convey("Given a graph not conforming to a shape", t, func() {
+		g, err := shacl.ParseNQuads(dataGraph)
+		So(err, ShouldBeNil)
+
+		report, err := shacl.Validate(g, shacl.NodeShape{
+			ID:          ex + "PersonShape",
+			TargetClass: ex + "Person",
+			Properties: []shacl.PropertyShape{
+				{Path: ex + "knows", Node: &shacl.NodeShape{
+					ID:         ex + "FriendShape",
+					Properties: []shacl.PropertyShape{{Path: ex + "age", MinCount: 1}},
+				}},
+			},
+		})
+		So(err, ShouldBeNil)
+
+		Convey("When the error of the report is returned", func() {
+			err := report.Err()
+
+			Convey("Then it should describe each violation", func() {
+				So(err.Error(), ShouldEqual, "https://example.org/alice <https://example.org/knows>: "+
+					"value _:b0 does not conform to shape https://example.org/FriendShape "+
+					"(_:b0 <https://example.org/age>: expected at least 1 value(s), got 0); "+
+					"https://example.org/carol <https://example.org/knows>: "+
+					"value https://example.org/dave does not conform to shape https://example.org/FriendShape "+
+					"(https://example.org/dave <https://example.org/age>: expected at least 1 value(s), got 0)")
+			})
+		})
+	})
+
+	Convey("Given a shape with an invalid pattern", t, func() {
+		g, err := shacl.ParseNQuads(dataGraph)
+		So(err, ShouldBeNil)
+
+		Convey("When a graph is validated against it", func() {
+			report, err := shacl.Validate(g, shacl.NodeShape{
+				ID:          ex + "PersonShape",
+				TargetClass: ex + "Person",
+				Properties:  []shacl.PropertyShape{{Path: ex + "name", Pattern: "("}},
+			})
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "invalid pattern (: ")
+				So(report, ShouldBeNil)
+			})
+		})
+	})
+}
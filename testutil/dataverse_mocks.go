@@ -13,9 +13,11 @@ import (
 	context "context"
 	reflect "reflect"
 
+	schema "github.com/axone-protocol/axone-contract-schema/go/cognitarium-schema/v6"
 	dataverse "github.com/axone-protocol/axone-sdk/dataverse"
 	types "github.com/cosmos/cosmos-sdk/types"
 	verifiable "github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	ld "github.com/piprate/json-gold/ld"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,6 +44,66 @@ func (m *MockQueryClient) EXPECT() *MockQueryClientMockRecorder {
 	return m.recorder
 }
 
+// AnalyzeGov mocks base method.
+func (m *MockQueryClient) AnalyzeGov(arg0 context.Context, arg1 string) (*dataverse.GovReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnalyzeGov", arg0, arg1)
+	ret0, _ := ret[0].(*dataverse.GovReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnalyzeGov indicates an expected call of AnalyzeGov.
+func (mr *MockQueryClientMockRecorder) AnalyzeGov(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeGov", reflect.TypeOf((*MockQueryClient)(nil).AnalyzeGov), arg0, arg1)
+}
+
+// AskGov mocks base method.
+func (m *MockQueryClient) AskGov(arg0 context.Context, arg1, arg2 string) (*dataverse.GovAnswer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AskGov", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dataverse.GovAnswer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AskGov indicates an expected call of AskGov.
+func (mr *MockQueryClientMockRecorder) AskGov(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskGov", reflect.TypeOf((*MockQueryClient)(nil).AskGov), arg0, arg1, arg2)
+}
+
+// AskGovDecision mocks base method.
+func (m *MockQueryClient) AskGovDecision(arg0 context.Context, arg1, arg2, arg3 string) (*dataverse.Decision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AskGovDecision", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*dataverse.Decision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AskGovDecision indicates an expected call of AskGovDecision.
+func (mr *MockQueryClientMockRecorder) AskGovDecision(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskGovDecision", reflect.TypeOf((*MockQueryClient)(nil).AskGovDecision), arg0, arg1, arg2, arg3)
+}
+
+// AskGovDecisions mocks base method.
+func (m *MockQueryClient) AskGovDecisions(arg0 context.Context, arg1 string, arg2 []dataverse.Permission) ([]*dataverse.Decision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AskGovDecisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*dataverse.Decision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AskGovDecisions indicates an expected call of AskGovDecisions.
+func (mr *MockQueryClientMockRecorder) AskGovDecisions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskGovDecisions", reflect.TypeOf((*MockQueryClient)(nil).AskGovDecisions), arg0, arg1, arg2)
+}
+
 // AskGovPermittedActions mocks base method.
 func (m *MockQueryClient) AskGovPermittedActions(arg0 context.Context, arg1, arg2 string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +134,21 @@ func (mr *MockQueryClientMockRecorder) AskGovTellAction(arg0, arg1, arg2, arg3 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskGovTellAction", reflect.TypeOf((*MockQueryClient)(nil).AskGovTellAction), arg0, arg1, arg2, arg3)
 }
 
+// AskResourcesDecisions mocks base method.
+func (m *MockQueryClient) AskResourcesDecisions(arg0 context.Context, arg1 []dataverse.ResourcePermission) ([]*dataverse.Decision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AskResourcesDecisions", arg0, arg1)
+	ret0, _ := ret[0].([]*dataverse.Decision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AskResourcesDecisions indicates an expected call of AskResourcesDecisions.
+func (mr *MockQueryClientMockRecorder) AskResourcesDecisions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskResourcesDecisions", reflect.TypeOf((*MockQueryClient)(nil).AskResourcesDecisions), arg0, arg1)
+}
+
 // CognitariumInfo mocks base method.
 func (m *MockQueryClient) CognitariumInfo(arg0 context.Context) (*dataverse.CognitariumInfo, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +164,21 @@ func (mr *MockQueryClientMockRecorder) CognitariumInfo(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CognitariumInfo", reflect.TypeOf((*MockQueryClient)(nil).CognitariumInfo), arg0)
 }
 
+// ConstructResource mocks base method.
+func (m *MockQueryClient) ConstructResource(arg0 context.Context, arg1 string, arg2 dataverse.RDFFormat) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConstructResource", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConstructResource indicates an expected call of ConstructResource.
+func (mr *MockQueryClientMockRecorder) ConstructResource(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConstructResource", reflect.TypeOf((*MockQueryClient)(nil).ConstructResource), arg0, arg1, arg2)
+}
+
 // DataverseInfo mocks base method.
 func (m *MockQueryClient) DataverseInfo(arg0 context.Context) (*dataverse.Info, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +194,36 @@ func (mr *MockQueryClientMockRecorder) DataverseInfo(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DataverseInfo", reflect.TypeOf((*MockQueryClient)(nil).DataverseInfo), arg0)
 }
 
+// DescribeResource mocks base method.
+func (m *MockQueryClient) DescribeResource(arg0 context.Context, arg1 string, arg2 dataverse.RDFFormat) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeResource", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeResource indicates an expected call of DescribeResource.
+func (mr *MockQueryClientMockRecorder) DescribeResource(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeResource", reflect.TypeOf((*MockQueryClient)(nil).DescribeResource), arg0, arg1, arg2)
+}
+
+// FetchBindings mocks base method.
+func (m *MockQueryClient) FetchBindings(arg0 context.Context, arg1 schema.SelectQuery) ([]map[string]schema.Value, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBindings", arg0, arg1)
+	ret0, _ := ret[0].([]map[string]schema.Value)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchBindings indicates an expected call of FetchBindings.
+func (mr *MockQueryClientMockRecorder) FetchBindings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBindings", reflect.TypeOf((*MockQueryClient)(nil).FetchBindings), arg0, arg1)
+}
+
 // GetResourceGovAddr mocks base method.
 func (m *MockQueryClient) GetResourceGovAddr(arg0 context.Context, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +239,51 @@ func (mr *MockQueryClientMockRecorder) GetResourceGovAddr(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceGovAddr", reflect.TypeOf((*MockQueryClient)(nil).GetResourceGovAddr), arg0, arg1)
 }
 
+// GetResourcePublications mocks base method.
+func (m *MockQueryClient) GetResourcePublications(arg0 context.Context, arg1 string) ([]dataverse.Publication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourcePublications", arg0, arg1)
+	ret0, _ := ret[0].([]dataverse.Publication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourcePublications indicates an expected call of GetResourcePublications.
+func (mr *MockQueryClientMockRecorder) GetResourcePublications(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcePublications", reflect.TypeOf((*MockQueryClient)(nil).GetResourcePublications), arg0, arg1)
+}
+
+// GetSubjectClaims mocks base method.
+func (m *MockQueryClient) GetSubjectClaims(arg0 context.Context, arg1 string) (map[string][]dataverse.ClaimRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubjectClaims", arg0, arg1)
+	ret0, _ := ret[0].(map[string][]dataverse.ClaimRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubjectClaims indicates an expected call of GetSubjectClaims.
+func (mr *MockQueryClientMockRecorder) GetSubjectClaims(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubjectClaims", reflect.TypeOf((*MockQueryClient)(nil).GetSubjectClaims), arg0, arg1)
+}
+
+// GetZoneMembers mocks base method.
+func (m *MockQueryClient) GetZoneMembers(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetZoneMembers", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetZoneMembers indicates an expected call of GetZoneMembers.
+func (mr *MockQueryClientMockRecorder) GetZoneMembers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneMembers", reflect.TypeOf((*MockQueryClient)(nil).GetZoneMembers), arg0, arg1)
+}
+
 // GovCode mocks base method.
 func (m *MockQueryClient) GovCode(arg0 context.Context, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -132,6 +299,51 @@ func (mr *MockQueryClientMockRecorder) GovCode(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GovCode", reflect.TypeOf((*MockQueryClient)(nil).GovCode), arg0, arg1)
 }
 
+// GovProgram mocks base method.
+func (m *MockQueryClient) GovProgram(arg0 context.Context, arg1 string) (*dataverse.Program, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GovProgram", arg0, arg1)
+	ret0, _ := ret[0].(*dataverse.Program)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GovProgram indicates an expected call of GovProgram.
+func (mr *MockQueryClientMockRecorder) GovProgram(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GovProgram", reflect.TypeOf((*MockQueryClient)(nil).GovProgram), arg0, arg1)
+}
+
+// IsGovBroken mocks base method.
+func (m *MockQueryClient) IsGovBroken(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsGovBroken", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsGovBroken indicates an expected call of IsGovBroken.
+func (mr *MockQueryClientMockRecorder) IsGovBroken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsGovBroken", reflect.TypeOf((*MockQueryClient)(nil).IsGovBroken), arg0, arg1)
+}
+
+// SearchDatasets mocks base method.
+func (m *MockQueryClient) SearchDatasets(arg0 context.Context, arg1 dataverse.DatasetFilter, arg2 dataverse.Pagination) (*dataverse.DatasetPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchDatasets", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dataverse.DatasetPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchDatasets indicates an expected call of SearchDatasets.
+func (mr *MockQueryClientMockRecorder) SearchDatasets(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchDatasets", reflect.TypeOf((*MockQueryClient)(nil).SearchDatasets), arg0, arg1, arg2)
+}
+
 // MockDataverseTxClient is a mock of TxClient interface.
 type MockDataverseTxClient struct {
 	ctrl     *gomock.Controller
@@ -155,17 +367,120 @@ func (m *MockDataverseTxClient) EXPECT() *MockDataverseTxClientMockRecorder {
 	return m.recorder
 }
 
+// BreakGov mocks base method.
+func (m *MockDataverseTxClient) BreakGov(ctx context.Context, addr string) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BreakGov", ctx, addr)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BreakGov indicates an expected call of BreakGov.
+func (mr *MockDataverseTxClientMockRecorder) BreakGov(ctx, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BreakGov", reflect.TypeOf((*MockDataverseTxClient)(nil).BreakGov), ctx, addr)
+}
+
+// DeployGovernance mocks base method.
+func (m *MockDataverseTxClient) DeployGovernance(ctx context.Context, spec dataverse.GovernanceSpec, descriptor dataverse.GovernanceDescriptorFunc, documentLoader ld.DocumentLoader) (*dataverse.GovernanceDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployGovernance", ctx, spec, descriptor, documentLoader)
+	ret0, _ := ret[0].(*dataverse.GovernanceDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployGovernance indicates an expected call of DeployGovernance.
+func (mr *MockDataverseTxClientMockRecorder) DeployGovernance(ctx, spec, descriptor, documentLoader any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployGovernance", reflect.TypeOf((*MockDataverseTxClient)(nil).DeployGovernance), ctx, spec, descriptor, documentLoader)
+}
+
+// RevokeClaims mocks base method.
+func (m *MockDataverseTxClient) RevokeClaims(ctx context.Context, credentialID string) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeClaims", ctx, credentialID)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeClaims indicates an expected call of RevokeClaims.
+func (mr *MockDataverseTxClientMockRecorder) RevokeClaims(ctx, credentialID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeClaims", reflect.TypeOf((*MockDataverseTxClient)(nil).RevokeClaims), ctx, credentialID)
+}
+
 // SubmitClaims mocks base method.
-func (m *MockDataverseTxClient) SubmitClaims(ctx context.Context, credential *verifiable.Credential) (*types.TxResponse, error) {
+func (m *MockDataverseTxClient) SubmitClaims(ctx context.Context, credential *verifiable.Credential, opts ...dataverse.SubmitOption) (*types.TxResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubmitClaims", ctx, credential)
+	varargs := []any{ctx, credential}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubmitClaims", varargs...)
 	ret0, _ := ret[0].(*types.TxResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SubmitClaims indicates an expected call of SubmitClaims.
-func (mr *MockDataverseTxClientMockRecorder) SubmitClaims(ctx, credential any) *gomock.Call {
+func (mr *MockDataverseTxClientMockRecorder) SubmitClaims(ctx, credential any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, credential}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitClaims", reflect.TypeOf((*MockDataverseTxClient)(nil).SubmitClaims), varargs...)
+}
+
+// MockCognitariumTxClient is a mock of CognitariumTxClient interface.
+type MockCognitariumTxClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCognitariumTxClientMockRecorder
+}
+
+// MockCognitariumTxClientMockRecorder is the mock recorder for MockCognitariumTxClient.
+type MockCognitariumTxClientMockRecorder struct {
+	mock *MockCognitariumTxClient
+}
+
+// NewMockCognitariumTxClient creates a new mock instance.
+func NewMockCognitariumTxClient(ctrl *gomock.Controller) *MockCognitariumTxClient {
+	mock := &MockCognitariumTxClient{ctrl: ctrl}
+	mock.recorder = &MockCognitariumTxClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCognitariumTxClient) EXPECT() *MockCognitariumTxClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteData mocks base method.
+func (m *MockCognitariumTxClient) DeleteData(ctx context.Context, templates, where []dataverse.TriplePattern) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteData", ctx, templates, where)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteData indicates an expected call of DeleteData.
+func (mr *MockCognitariumTxClientMockRecorder) DeleteData(ctx, templates, where any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteData", reflect.TypeOf((*MockCognitariumTxClient)(nil).DeleteData), ctx, templates, where)
+}
+
+// InsertData mocks base method.
+func (m *MockCognitariumTxClient) InsertData(ctx context.Context, data []byte, format dataverse.RDFFormat) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertData", ctx, data, format)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertData indicates an expected call of InsertData.
+func (mr *MockCognitariumTxClientMockRecorder) InsertData(ctx, data, format any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitClaims", reflect.TypeOf((*MockDataverseTxClient)(nil).SubmitClaims), ctx, credential)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertData", reflect.TypeOf((*MockCognitariumTxClient)(nil).InsertData), ctx, data, format)
 }
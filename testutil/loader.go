@@ -22,6 +22,12 @@ var (
 	mockDatasetV4JSONLD []byte
 	//go:embed contexts/publication-v4.jsonld
 	mockPublicationV4JSONLD []byte
+	//go:embed contexts/digital-service-description-v4.jsonld
+	mockDigitalServiceDescriptionV4JSONLD []byte
+	//go:embed contexts/zone-description-v4.jsonld
+	mockZoneDescriptionV4JSONLD []byte
+	//go:embed contexts/zone-membership-v4.jsonld
+	mockZoneMembershipV4JSONLD []byte
 )
 
 func MockDocumentLoader() (*jld.DocumentLoader, error) {
@@ -50,6 +56,18 @@ func MockDocumentLoader() (*jld.DocumentLoader, error) {
 			URL:     "https://w3id.org/axone/ontology/v4/schema/credential/digital-resource/publication/",
 			Content: mockPublicationV4JSONLD,
 		},
+		ldcontext.Document{
+			URL:     "https://w3id.org/axone/ontology/v4/schema/credential/digital-service/description/",
+			Content: mockDigitalServiceDescriptionV4JSONLD,
+		},
+		ldcontext.Document{
+			URL:     "https://w3id.org/axone/ontology/v4/schema/credential/zone/description/",
+			Content: mockZoneDescriptionV4JSONLD,
+		},
+		ldcontext.Document{
+			URL:     "https://w3id.org/axone/ontology/v4/schema/credential/zone/membership/",
+			Content: mockZoneMembershipV4JSONLD,
+		},
 	))
 }
 
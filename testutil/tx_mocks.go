@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=tx/client.go -mock_names Client=MockTxClient -package testutil -destination testutil/tx_mocks.go
+//	mockgen -source=tx/client.go -mock_names Client=MockTxClient,Getter=MockTxGetter,Simulator=MockTxSimulator -package testutil -destination testutil/tx_mocks.go
 //
 
 // Package testutil is a generated GoMock package.
@@ -15,6 +15,7 @@ import (
 
 	tx "github.com/axone-protocol/axone-sdk/tx"
 	types "github.com/cosmos/cosmos-sdk/types"
+	tx0 "github.com/cosmos/cosmos-sdk/types/tx"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -55,3 +56,79 @@ func (mr *MockTxClientMockRecorder) SendTx(ctx, transaction any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTx", reflect.TypeOf((*MockTxClient)(nil).SendTx), ctx, transaction)
 }
+
+// MockTxGetter is a mock of Getter interface.
+type MockTxGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxGetterMockRecorder
+}
+
+// MockTxGetterMockRecorder is the mock recorder for MockTxGetter.
+type MockTxGetterMockRecorder struct {
+	mock *MockTxGetter
+}
+
+// NewMockTxGetter creates a new mock instance.
+func NewMockTxGetter(ctrl *gomock.Controller) *MockTxGetter {
+	mock := &MockTxGetter{ctrl: ctrl}
+	mock.recorder = &MockTxGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxGetter) EXPECT() *MockTxGetterMockRecorder {
+	return m.recorder
+}
+
+// GetTx mocks base method.
+func (m *MockTxGetter) GetTx(ctx context.Context, hash string) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTx", ctx, hash)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTx indicates an expected call of GetTx.
+func (mr *MockTxGetterMockRecorder) GetTx(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockTxGetter)(nil).GetTx), ctx, hash)
+}
+
+// MockTxSimulator is a mock of Simulator interface.
+type MockTxSimulator struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxSimulatorMockRecorder
+}
+
+// MockTxSimulatorMockRecorder is the mock recorder for MockTxSimulator.
+type MockTxSimulatorMockRecorder struct {
+	mock *MockTxSimulator
+}
+
+// NewMockTxSimulator creates a new mock instance.
+func NewMockTxSimulator(ctrl *gomock.Controller) *MockTxSimulator {
+	mock := &MockTxSimulator{ctrl: ctrl}
+	mock.recorder = &MockTxSimulatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxSimulator) EXPECT() *MockTxSimulatorMockRecorder {
+	return m.recorder
+}
+
+// Simulate mocks base method.
+func (m *MockTxSimulator) Simulate(ctx context.Context, transaction tx.Transaction) (*tx0.SimulateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Simulate", ctx, transaction)
+	ret0, _ := ret[0].(*tx0.SimulateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Simulate indicates an expected call of Simulate.
+func (mr *MockTxSimulatorMockRecorder) Simulate(ctx, transaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Simulate", reflect.TypeOf((*MockTxSimulator)(nil).Simulate), ctx, transaction)
+}
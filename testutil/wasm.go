@@ -0,0 +1,108 @@
+package testutil
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WasmServer is a local gRPC server standing for the wasm module of a node. It answers the smart queries of contracts
+// with the responses given by contract address and query name, e.g. "axone1dataverse/dataverse"; queries without
+// response fail as the node does for an unknown contract.
+type WasmServer struct {
+	wasmtypes.UnimplementedQueryServer
+
+	// Addr is the address the server listens on.
+	Addr string
+
+	responses map[string]json.RawMessage
+	server    *grpc.Server
+	wg        sync.WaitGroup
+	queries   atomic.Int64
+	conns     atomic.Int64
+}
+
+// NewWasmServer starts a WasmServer on a local port, answering with the given responses marshaled in JSON.
+func NewWasmServer(responses map[string]any) (*WasmServer, error) {
+	s := &WasmServer{responses: make(map[string]json.RawMessage, len(responses))}
+	for key, response := range responses {
+		raw, err := json.Marshal(response)
+		if err != nil {
+			return nil, err
+		}
+		s.responses[key] = raw
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s.Addr = listener.Addr().String()
+
+	s.server = grpc.NewServer()
+	wasmtypes.RegisterQueryServer(s.server, s)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		_ = s.server.Serve(&countingListener{Listener: listener, conns: &s.conns})
+	}()
+
+	return s, nil
+}
+
+// Queries returns the number of smart queries received.
+func (s *WasmServer) Queries() int64 {
+	return s.queries.Load()
+}
+
+// Conns returns the number of connections accepted.
+func (s *WasmServer) Conns() int64 {
+	return s.conns.Load()
+}
+
+// Close stops the server, closing its connections.
+func (s *WasmServer) Close() {
+	s.server.Stop()
+	s.wg.Wait()
+}
+
+func (s *WasmServer) SmartContractState(
+	_ context.Context,
+	req *wasmtypes.QuerySmartContractStateRequest,
+) (*wasmtypes.QuerySmartContractStateResponse, error) {
+	s.queries.Add(1)
+
+	var msg map[string]json.RawMessage
+	if err := json.Unmarshal(req.QueryData, &msg); err != nil || len(msg) != 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid query request")
+	}
+	for name := range msg {
+		if response, ok := s.responses[req.Address+"/"+name]; ok {
+			return &wasmtypes.QuerySmartContractStateResponse{Data: wasmtypes.RawContractMessage(response)}, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "no such contract: address %s", req.Address)
+}
+
+// countingListener counts the connections it accepts.
+type countingListener struct {
+	net.Listener
+	conns *atomic.Int64
+}
+
+func (l *countingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err == nil {
+		l.conns.Add(1)
+	}
+	return conn, err
+}
@@ -20,6 +20,24 @@ type Client interface {
 	SendTx(ctx context.Context, transaction Transaction) (*sdk.TxResponse, error)
 }
 
+// Getter retrieves the transactions included in blocks. The Client returned by NewClient implements it.
+type Getter interface {
+	// GetTx returns the response of a transaction included in a block given its hash.
+	GetTx(ctx context.Context, hash string) (*sdk.TxResponse, error)
+}
+
+// Simulator simulates the execution of transactions. The Client returned by NewClient implements it.
+type Simulator interface {
+	// Simulate signs a transaction and simulates its execution without broadcasting it, returning the gas it uses and
+	// the result of its messages. An error is returned if the execution of the transaction fails.
+	Simulate(ctx context.Context, transaction Transaction) (*tx.SimulateResponse, error)
+}
+
+var (
+	_ Getter    = &client{}
+	_ Simulator = &client{}
+)
+
 type client struct {
 	authClient      authtypes.QueryClient
 	txServiceClient tx.ServiceClient
@@ -35,14 +53,9 @@ func NewClient(authClient authtypes.QueryClient, txServiceClient tx.ServiceClien
 }
 
 func (c *client) SendTx(ctx context.Context, transaction Transaction) (*sdk.TxResponse, error) {
-	accNum, accSeq, err := c.getAccountNumberSequence(ctx, transaction.Sender())
+	txEncoded, err := c.signTx(ctx, transaction)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get account number and sequence: %w", err)
-	}
-
-	txEncoded, err := transaction.GetSignedTx(ctx, accNum, accSeq, c.chainID)
-	if err != nil {
-		return nil, fmt.Errorf("failed build a signed tx: %w", err)
+		return nil, err
 	}
 
 	resp, err := c.txServiceClient.BroadcastTx(
@@ -55,6 +68,41 @@ func (c *client) SendTx(ctx context.Context, transaction Transaction) (*sdk.TxRe
 	return resp.TxResponse, nil
 }
 
+func (c *client) GetTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	resp, err := c.txServiceClient.GetTx(ctx, &tx.GetTxRequest{Hash: hash})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+	return resp.TxResponse, nil
+}
+
+func (c *client) Simulate(ctx context.Context, transaction Transaction) (*tx.SimulateResponse, error) {
+	txEncoded, err := c.signTx(ctx, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.txServiceClient.Simulate(ctx, &tx.SimulateRequest{TxBytes: txEncoded})
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate tx: %w", err)
+	}
+	return resp, nil
+}
+
+// signTx returns the encoded transaction signed with the current account number and sequence of its sender.
+func (c *client) signTx(ctx context.Context, transaction Transaction) ([]byte, error) {
+	accNum, accSeq, err := c.getAccountNumberSequence(ctx, transaction.Sender())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account number and sequence: %w", err)
+	}
+
+	txEncoded, err := transaction.GetSignedTx(ctx, accNum, accSeq, c.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed build a signed tx: %w", err)
+	}
+	return txEncoded, nil
+}
+
 func (c *client) getAccountNumberSequence(ctx context.Context, addr string) (uint64, uint64, error) {
 	resp, err := c.authClient.Account(ctx, &authtypes.QueryAccountRequest{Address: addr})
 	if err != nil {
@@ -126,3 +126,122 @@ func TestClient_SendTx(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetTx(t *testing.T) {
+	tests := []struct {
+		name        string
+		response    *sdktx.GetTxResponse
+		responseErr error
+		wantErr     error
+	}{
+		{
+			name:     "included transaction",
+			response: &sdktx.GetTxResponse{TxResponse: &sdktype.TxResponse{TxHash: "hash", Height: 42}},
+		},
+		{
+			name:        "get tx error",
+			responseErr: fmt.Errorf("not found"),
+			wantErr:     fmt.Errorf("failed to get tx: not found"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a client with mocked tx service", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				mockTxService := testutil.NewMockTxServiceClient(controller)
+				mockTxService.EXPECT().
+					GetTx(gomock.Any(), &sdktx.GetTxRequest{Hash: "hash"}).
+					Return(test.response, test.responseErr)
+
+				client, ok := tx.NewClient(testutil.NewMockAuthQueryClient(controller), mockTxService, "chainID").(tx.Getter)
+				So(ok, ShouldBeTrue)
+
+				Convey("When GetTx is called", func() {
+					result, err := client.GetTx(context.Background(), "hash")
+
+					Convey("Then the transaction response should be returned", func() {
+						if test.wantErr != nil {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(result, ShouldBeNil)
+						} else {
+							So(err, ShouldBeNil)
+							So(result, ShouldEqual, test.response.TxResponse)
+						}
+					})
+				})
+			})
+		})
+	}
+}
+
+func TestClient_Simulate(t *testing.T) {
+	acc := &authtypes.BaseAccount{
+		AccountNumber: 20,
+		Sequence:      19,
+	}
+	accByte, err := acc.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		response    *sdktx.SimulateResponse
+		simulateErr error
+		wantErr     error
+	}{
+		{
+			name:     "successful simulation",
+			response: &sdktx.SimulateResponse{GasInfo: &sdktype.GasInfo{GasWanted: 2000000, GasUsed: 150000}},
+		},
+		{
+			name:        "failed execution",
+			simulateErr: fmt.Errorf("execute wasm contract failed"),
+			wantErr:     fmt.Errorf("failed to simulate tx: execute wasm contract failed"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a client with mocked auth client and tx service", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				mockAuthClient := testutil.NewMockAuthQueryClient(controller)
+				mockTxService := testutil.NewMockTxServiceClient(controller)
+				mockTransaction := testutil.NewMockTransaction(controller)
+
+				mockTransaction.EXPECT().Sender().Return("axone1").Times(1)
+				mockAuthClient.EXPECT().
+					Account(gomock.Any(), &authtypes.QueryAccountRequest{Address: "axone1"}).
+					Return(&authtypes.QueryAccountResponse{Account: &types.Any{Value: accByte}}, nil)
+				mockTransaction.EXPECT().
+					GetSignedTx(gomock.Any(), uint64(20), uint64(19), "chainID").
+					Return([]byte("txEncoded"), nil)
+				mockTxService.EXPECT().
+					Simulate(gomock.Any(), &sdktx.SimulateRequest{TxBytes: []byte("txEncoded")}).
+					Return(test.response, test.simulateErr)
+
+				client, ok := tx.NewClient(mockAuthClient, mockTxService, "chainID").(tx.Simulator)
+				So(ok, ShouldBeTrue)
+
+				Convey("When Simulate is called", func() {
+					result, err := client.Simulate(context.Background(), mockTransaction)
+
+					Convey("Then the simulation response should be returned", func() {
+						if test.wantErr != nil {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(result, ShouldBeNil)
+						} else {
+							So(err, ShouldBeNil)
+							So(result, ShouldEqual, test.response)
+						}
+					})
+				})
+			})
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package tx
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WaitTx waits for the transaction identified by its hash to be included in a block, polling the client at the given
+// interval until it is found or the context is done. It returns the response of the included transaction, carrying
+// its execution result and events.
+func WaitTx(ctx context.Context, client Getter, hash string, interval time.Duration) (*sdk.TxResponse, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := client.GetTx(ctx, hash)
+		if err == nil {
+			return resp, nil
+		}
+		if status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package tx_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/axone-protocol/axone-sdk/testutil"
+	"github.com/axone-protocol/axone-sdk/tx"
+	sdktype "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWaitTx(t *testing.T) {
+	notFound := fmt.Errorf("failed to get tx: %w", status.Error(codes.NotFound, "tx not found"))
+
+	tests := []struct {
+		name      string
+		errors    []error
+		timeout   time.Duration
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "transaction already included",
+			errors:    []error{nil},
+			timeout:   time.Second,
+			wantCalls: 1,
+		},
+		{
+			name:      "transaction included after some blocks",
+			errors:    []error{notFound, notFound, nil},
+			timeout:   time.Second,
+			wantCalls: 3,
+		},
+		{
+			name:      "unexpected error",
+			errors:    []error{notFound, fmt.Errorf("connection refused")},
+			timeout:   time.Second,
+			wantCalls: 2,
+			wantErr:   fmt.Errorf("connection refused"),
+		},
+		{
+			name:      "transaction never included",
+			errors:    []error{notFound, notFound, notFound, notFound, notFound, notFound},
+			timeout:   25 * time.Millisecond,
+			wantCalls: -1,
+			wantErr:   context.DeadlineExceeded,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Convey("Given a mocked tx client", t, func() {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				calls := 0
+				mockClient := testutil.NewMockTxGetter(controller)
+				mockClient.EXPECT().
+					GetTx(gomock.Any(), "hash").
+					DoAndReturn(func(_ context.Context, hash string) (*sdktype.TxResponse, error) {
+						err := test.errors[min(calls, len(test.errors)-1)]
+						calls++
+						if err != nil {
+							return nil, err
+						}
+						return &sdktype.TxResponse{TxHash: hash}, nil
+					}).
+					AnyTimes()
+
+				ctx, cancel := context.WithTimeout(context.Background(), test.timeout)
+				defer cancel()
+
+				Convey("When WaitTx is called", func() {
+					resp, err := tx.WaitTx(ctx, mockClient, "hash", 10*time.Millisecond)
+
+					Convey("Then it should wait for the transaction to be included", func() {
+						if test.wantErr != nil {
+							So(err.Error(), ShouldEqual, test.wantErr.Error())
+							So(resp, ShouldBeNil)
+						} else {
+							So(err, ShouldBeNil)
+							So(resp.TxHash, ShouldEqual, "hash")
+						}
+						if test.wantCalls != -1 {
+							So(calls, ShouldEqual, test.wantCalls)
+						}
+					})
+				})
+			})
+		})
+	}
+}